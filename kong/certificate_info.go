@@ -0,0 +1,146 @@
+package kong
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// the short names used for the common attributes when a subject or issuer is formatted
+var certificateNameAttributes = map[string]string{
+	"2.5.4.3":  "CN",
+	"2.5.4.5":  "SERIALNUMBER",
+	"2.5.4.6":  "C",
+	"2.5.4.7":  "L",
+	"2.5.4.8":  "ST",
+	"2.5.4.9":  "STREET",
+	"2.5.4.10": "O",
+	"2.5.4.11": "OU",
+	"2.5.4.17": "POSTALCODE",
+}
+
+// parseCertificatePem returns the first certificate in the pem, nil when the value does not hold a pem encoded certificate
+func parseCertificatePem(certificatePem string) (*x509.Certificate, error) {
+
+	block, _ := pem.Decode([]byte(certificatePem))
+
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, nil
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// normalizePem re-encodes every pem block so line endings, line wrapping, indentation and surrounding whitespace do
+// not matter, values that are not pem encoded are only trimmed
+func normalizePem(value string) string {
+
+	lines := strings.Split(strings.Replace(value, "\r\n", "\n", -1), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+
+	var blocks []string
+	rest := []byte(strings.Join(lines, "\n"))
+
+	for {
+		block, remaining := pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		blocks = append(blocks, string(pem.EncodeToMemory(block)))
+		rest = remaining
+	}
+
+	if len(blocks) == 0 {
+		return strings.TrimSpace(value)
+	}
+
+	return strings.Join(blocks, "")
+}
+
+// hashPrivateKey returns the hex encoded sha256 of the normalized private key, the hash is kept in state instead of the key
+func hashPrivateKey(privateKey string) string {
+
+	if privateKey == "" {
+		return ""
+	}
+
+	hash := sha256.Sum256([]byte(normalizePem(privateKey)))
+	return hex.EncodeToString(hash[:])
+}
+
+// validateCertificateKeyPair returns an error when the private key does not belong to the certificate, values that
+// are not pem encoded are left for kong to validate
+func validateCertificateKeyPair(certificatePem string, privateKeyPem string) error {
+
+	if certificatePem == "" || privateKeyPem == "" {
+		return nil
+	}
+
+	if block, _ := pem.Decode([]byte(certificatePem)); block == nil {
+		return nil
+	}
+
+	if block, _ := pem.Decode([]byte(privateKeyPem)); block == nil {
+		return nil
+	}
+
+	if _, err := tls.X509KeyPair([]byte(certificatePem), []byte(privateKeyPem)); err != nil {
+		return fmt.Errorf("the private key does not match the certificate: %v", err)
+	}
+
+	return nil
+}
+
+// validateCertificateExpiry returns an error when the certificate expires within the minimum number of days
+func validateCertificateExpiry(certificate *x509.Certificate, minDaysRemaining int, now time.Time) error {
+
+	if certificate == nil || minDaysRemaining <= 0 {
+		return nil
+	}
+
+	if daysRemaining := certificateDaysRemaining(certificate, now); daysRemaining < minDaysRemaining {
+		return fmt.Errorf("the certificate for %s expires on %s which is %d days away, at least %d days are required",
+			formatCertificateName(certificate.Subject), certificate.NotAfter.UTC().Format(time.RFC3339), daysRemaining, minDaysRemaining)
+	}
+
+	return nil
+}
+
+func certificateDaysRemaining(certificate *x509.Certificate, now time.Time) int {
+	return int(certificate.NotAfter.Sub(now).Hours() / 24)
+}
+
+func certificateFingerprintSha256(certificate *x509.Certificate) string {
+	fingerprint := sha256.Sum256(certificate.Raw)
+	return hex.EncodeToString(fingerprint[:])
+}
+
+// formatCertificateName formats the name in the same order as the certificate, e.g. CN=www.example.com,O=Example
+func formatCertificateName(name pkix.Name) string {
+
+	var parts []string
+
+	for _, attribute := range name.Names {
+		parts = append(parts, fmt.Sprintf("%s=%v", certificateNameAttribute(attribute.Type), attribute.Value))
+	}
+
+	return strings.Join(parts, ",")
+}
+
+func certificateNameAttribute(oid asn1.ObjectIdentifier) string {
+
+	if shortName, ok := certificateNameAttributes[oid.String()]; ok {
+		return shortName
+	}
+
+	return oid.String()
+}
@@ -0,0 +1,156 @@
+package kong
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseCertificatePem(t *testing.T) {
+
+	certificatePem, _ := generateTestCertificate(t, "www.example.com", time.Now().Add(90*24*time.Hour))
+
+	certificate, err := parseCertificatePem(certificatePem)
+
+	if err != nil {
+		t.Fatalf("unexpected error parsing certificate: %v", err)
+	}
+
+	if certificate == nil {
+		t.Fatal("expected the certificate to be parsed")
+	}
+
+	if subject := formatCertificateName(certificate.Subject); subject != "O=Terraform Kong,CN=www.example.com" {
+		t.Errorf("unexpected subject: %v", subject)
+	}
+
+	if len(certificate.DNSNames) != 1 || certificate.DNSNames[0] != "www.example.com" {
+		t.Errorf("unexpected dns names: %v", certificate.DNSNames)
+	}
+
+	if fingerprint := certificateFingerprintSha256(certificate); len(fingerprint) != 64 {
+		t.Errorf("unexpected fingerprint: %v", fingerprint)
+	}
+}
+
+func TestParseCertificatePemIgnoresValuesThatAreNotPem(t *testing.T) {
+
+	certificate, err := parseCertificatePem("public key --- 123 ----")
+
+	if err != nil || certificate != nil {
+		t.Errorf("expected no certificate and no error, got %v %v", certificate, err)
+	}
+}
+
+func TestNormalizePem(t *testing.T) {
+
+	certificatePem, _ := generateTestCertificate(t, "www.example.com", time.Now().Add(90*24*time.Hour))
+
+	if normalized := normalizePem("\r\n  " + certificatePem + "\n\n"); normalized != certificatePem {
+		t.Errorf("expected surrounding whitespace to be removed, got: %v", normalized)
+	}
+
+	if normalized := normalizePem(" public key --- 123 ----\n"); normalized != "public key --- 123 ----" {
+		t.Errorf("expected a value that is not pem to be trimmed, got: %v", normalized)
+	}
+}
+
+func TestHashPrivateKey(t *testing.T) {
+
+	_, privateKeyPem := generateTestCertificate(t, "www.example.com", time.Now().Add(90*24*time.Hour))
+	_, otherPrivateKeyPem := generateTestCertificate(t, "other.example.com", time.Now().Add(90*24*time.Hour))
+
+	hash := hashPrivateKey(privateKeyPem)
+
+	if len(hash) != 64 || strings.Contains(hash, "PRIVATE KEY") {
+		t.Errorf("expected a hex encoded sha256, got: %v", hash)
+	}
+
+	if reformatted := hashPrivateKey("\n" + privateKeyPem + "\n\n"); reformatted != hash {
+		t.Errorf("expected the hash to ignore formatting, got %v and %v", reformatted, hash)
+	}
+
+	if other := hashPrivateKey(otherPrivateKeyPem); other == hash {
+		t.Error("expected different keys to have different hashes")
+	}
+
+	if empty := hashPrivateKey(""); empty != "" {
+		t.Errorf("expected an empty key to have an empty hash, got: %v", empty)
+	}
+}
+
+func TestValidateCertificateKeyPair(t *testing.T) {
+
+	certificatePem, privateKeyPem := generateTestCertificate(t, "www.example.com", time.Now().Add(90*24*time.Hour))
+	_, otherPrivateKeyPem := generateTestCertificate(t, "other.example.com", time.Now().Add(90*24*time.Hour))
+
+	if err := validateCertificateKeyPair(certificatePem, privateKeyPem); err != nil {
+		t.Errorf("unexpected error for a matching key pair: %v", err)
+	}
+
+	if err := validateCertificateKeyPair(certificatePem, otherPrivateKeyPem); err == nil {
+		t.Error("expected an error for a private key from another certificate")
+	}
+
+	if err := validateCertificateKeyPair("public key --- 123 ----", "private key --- 456 ----"); err != nil {
+		t.Errorf("unexpected error for values that are not pem: %v", err)
+	}
+}
+
+func TestValidateCertificateExpiry(t *testing.T) {
+
+	now := time.Now()
+	certificatePem, _ := generateTestCertificate(t, "www.example.com", now.Add(10*24*time.Hour+time.Hour))
+	certificate, _ := parseCertificatePem(certificatePem)
+
+	if err := validateCertificateExpiry(certificate, 0, now); err != nil {
+		t.Errorf("unexpected error when no minimum is set: %v", err)
+	}
+
+	if err := validateCertificateExpiry(certificate, 10, now); err != nil {
+		t.Errorf("unexpected error when enough days remain: %v", err)
+	}
+
+	err := validateCertificateExpiry(certificate, 30, now)
+
+	if err == nil || !strings.Contains(err.Error(), "10 days away, at least 30 days are required") {
+		t.Errorf("expected an expiry error, got: %v", err)
+	}
+}
+
+// generateTestCertificate returns a self signed certificate and its private key as pem
+func generateTestCertificate(t *testing.T, commonName string, notAfter time.Time) (string, string) {
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+
+	if err != nil {
+		t.Fatalf("could not generate private key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName, Organization: []string{"Terraform Kong"}},
+		DNSNames:     []string{commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+
+	if err != nil {
+		t.Fatalf("could not create certificate: %v", err)
+	}
+
+	certificatePem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate})
+	privateKeyPem := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+
+	return string(certificatePem), string(privateKeyPem)
+}
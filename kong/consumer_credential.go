@@ -0,0 +1,22 @@
+package kong
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"strings"
+)
+
+// importConsumerCredential imports credentials that live under a consumer, the import id is consumer_id/credential_id
+func importConsumerCredential(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+
+	parts := strings.SplitN(d.Id(), "/", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("import id %s should be in the form consumer_id/credential_id", d.Id())
+	}
+
+	d.SetId(parts[1])
+	d.Set("consumer_id", parts[0])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,47 @@
+package kong
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"testing"
+)
+
+func TestImportConsumerCredential(t *testing.T) {
+
+	d := resourceKongConsumerKeyAuth().TestResourceData()
+	d.SetId("consumer-id/credential-id")
+
+	result, err := importConsumerCredential(d, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error importing credential: %v", err)
+	}
+
+	if len(result) != 1 || result[0].Id() != "credential-id" || result[0].Get("consumer_id") != "consumer-id" {
+		t.Errorf("expected the credential id and consumer id to be split from the import id")
+	}
+
+	d.SetId("credential-id")
+
+	if _, err := importConsumerCredential(d, nil); err == nil {
+		t.Error("expected an error for an import id without a consumer id")
+	}
+}
+
+// testAccSetConsumerCredentialImportId sets the import id of a later step to consumer_id/credential_id, the ids are
+// only known once the credential has been created
+func testAccSetConsumerCredentialImportId(steps *[]resource.TestStep, index int, resourceKey string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		(*steps)[index].ImportStateId = fmt.Sprintf("%s/%s", rs.Primary.Attributes["consumer_id"], rs.Primary.ID)
+
+		return nil
+	}
+}
@@ -0,0 +1,159 @@
+package kong
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kevholditch/gokong"
+)
+
+func dataSourceKongApis() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKongApisRead,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"upstream_url": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"retries": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"apis": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hosts": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"uris": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"methods": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"upstream_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"strip_uri": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"preserve_host": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"retries": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"upstream_connect_timeout": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"upstream_send_timeout": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"upstream_read_timeout": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"https_only": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"http_if_terminated": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKongApisRead(d *schema.ResourceData, meta interface{}) error {
+
+	filterMap := readListDataSourceFilter(d)
+	filter := &gokong.ApiFilter{}
+
+	if v, ok := filterMap["name"]; ok {
+		filter.Name = v.(string)
+	}
+	if v, ok := filterMap["upstream_url"]; ok {
+		filter.UpstreamUrl = v.(string)
+	}
+	if v, ok := filterMap["retries"]; ok {
+		filter.Retries = v.(int)
+	}
+
+	results, err := meta.(*gokong.KongAdminClient).Apis().ListAllFiltered(filter)
+
+	if err != nil {
+		return fmt.Errorf("could not list apis, error: %v", err)
+	}
+
+	var ids []string
+	var apis []map[string]interface{}
+
+	for _, api := range results {
+		ids = append(ids, api.Id)
+		apis = append(apis, map[string]interface{}{
+			"id":                       api.Id,
+			"name":                     api.Name,
+			"hosts":                    api.Hosts,
+			"uris":                     api.Uris,
+			"methods":                  api.Methods,
+			"upstream_url":             api.UpstreamUrl,
+			"strip_uri":                api.StripUri,
+			"preserve_host":            api.PreserveHost,
+			"retries":                  api.Retries,
+			"upstream_connect_timeout": api.UpstreamConnectTimeout,
+			"upstream_send_timeout":    api.UpstreamSendTimeout,
+			"upstream_read_timeout":    api.UpstreamReadTimeout,
+			"https_only":               api.HttpsOnly,
+			"http_if_terminated":       api.HttpIfTerminated,
+		})
+	}
+
+	d.SetId(listDataSourceId(ids))
+	d.Set("ids", ids)
+	d.Set("apis", apis)
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package kong
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceKongApis(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testApisDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kong_apis.apis_data_source", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.kong_apis.apis_data_source", "apis.#", "2"),
+					resource.TestCheckResourceAttr("data.kong_apis.apis_data_source", "apis.0.upstream_url", "http://localhost:4150"),
+					resource.TestCheckResourceAttr("data.kong_apis.single_api_data_source", "apis.#", "1"),
+					resource.TestCheckResourceAttr("data.kong_apis.single_api_data_source", "apis.0.name", "TestDataSourceApis1"),
+					resource.TestCheckResourceAttr("data.kong_apis.single_api_data_source", "apis.0.hosts.0", "example1.com"),
+				),
+			},
+		},
+	})
+}
+
+const testApisDataSourceConfig = `
+resource "kong_api" "api1" {
+	name 	     = "TestDataSourceApis1"
+	hosts        = [ "example1.com" ]
+	upstream_url = "http://localhost:4150"
+}
+
+resource "kong_api" "api2" {
+	name 	     = "TestDataSourceApis2"
+	hosts        = [ "example2.com" ]
+	upstream_url = "http://localhost:4150"
+}
+
+data "kong_apis" "apis_data_source" {
+	filter = {
+		upstream_url = "http://localhost:4150"
+	}
+	depends_on = ["kong_api.api1", "kong_api.api2"]
+}
+
+data "kong_apis" "single_api_data_source" {
+	filter = {
+		name = "${kong_api.api1.name}"
+	}
+}
+`
@@ -27,8 +27,9 @@ func dataSourceKongCertificate() *schema.Resource {
 				Computed: true,
 			},
 			"private_key": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 		},
 	}
@@ -58,7 +59,7 @@ func dataSourceKongCertificateRead(d *schema.ResourceData, meta interface{}) err
 
 	d.SetId(result.Id)
 	d.Set("certificate", result.Cert)
-	d.Set("private_key", result.Key)
+	d.Set("private_key", hashPrivateKey(result.Key))
 
 	return nil
 }
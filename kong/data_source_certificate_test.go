@@ -14,7 +14,7 @@ func TestAccDataSourceKongCertificate(t *testing.T) {
 				Config: testCertificateDataSourceConfig,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.kong_certificate.certificate_data_source", "certificate", "public key --- 777 ----"),
-					resource.TestCheckResourceAttr("data.kong_certificate.certificate_data_source", "private_key", "private key --- 888 ----"),
+					resource.TestCheckResourceAttr("data.kong_certificate.certificate_data_source", "private_key", hashPrivateKey("private key --- 888 ----")),
 				),
 			},
 		},
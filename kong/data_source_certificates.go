@@ -0,0 +1,122 @@
+package kong
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kevholditch/gokong"
+)
+
+func dataSourceKongCertificates() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKongCertificatesRead,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"sni": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"certificates": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"certificate": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_key": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKongCertificatesRead(d *schema.ResourceData, meta interface{}) error {
+
+	client := meta.(*gokong.KongAdminClient)
+
+	// kong cannot filter certificates so the filter is applied to the certificates that are returned
+	filterMap := readListDataSourceFilter(d)
+	var filterId string
+
+	if v, ok := filterMap["id"]; ok {
+		filterId = v.(string)
+	}
+
+	if v, ok := filterMap["sni"]; ok && v.(string) != "" {
+		sni, err := client.Snis().GetByName(v.(string))
+
+		if err != nil {
+			return fmt.Errorf("could not find sni %s, error: %v", v, err)
+		}
+
+		if sni == nil || (filterId != "" && filterId != sni.SslCertificateId) {
+			return setKongCertificatesDataSource(d, nil)
+		}
+
+		filterId = sni.SslCertificateId
+	}
+
+	results, err := client.Certificates().ListAllFiltered(nil)
+
+	if err != nil {
+		return fmt.Errorf("could not list certificates, error: %v", err)
+	}
+
+	var matching []*gokong.Certificate
+
+	for _, certificate := range results {
+		if filterId == "" || certificate.Id == filterId {
+			matching = append(matching, certificate)
+		}
+	}
+
+	return setKongCertificatesDataSource(d, matching)
+}
+
+func setKongCertificatesDataSource(d *schema.ResourceData, results []*gokong.Certificate) error {
+
+	var ids []string
+	var certificates []map[string]interface{}
+
+	for _, certificate := range results {
+		ids = append(ids, certificate.Id)
+		certificates = append(certificates, map[string]interface{}{
+			"id":          certificate.Id,
+			"certificate": certificate.Cert,
+			"private_key": hashPrivateKey(certificate.Key),
+		})
+	}
+
+	d.SetId(listDataSourceId(ids))
+	d.Set("ids", ids)
+	d.Set("certificates", certificates)
+
+	return nil
+}
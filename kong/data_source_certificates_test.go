@@ -0,0 +1,78 @@
+package kong
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceKongCertificates(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testCertificatesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kong_certificates.certificates_data_source", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.kong_certificates.certificates_data_source", "certificates.0.certificate", "public key --- 999 ----"),
+					resource.TestCheckResourceAttr("data.kong_certificates.certificates_data_source", "certificates.0.private_key", hashPrivateKey("private key --- 000 ----")),
+				),
+			},
+			{
+				Config: testCertificatesDataSourceFilterConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kong_certificates.by_sni", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.kong_certificates.by_sni", "certificates.0.certificate", "public key --- 999 ----"),
+					resource.TestCheckResourceAttr("data.kong_certificates.by_id", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.kong_certificates.by_id", "certificates.0.certificate", "public key --- 777 ----"),
+					resource.TestCheckResourceAttr("data.kong_certificates.no_match", "ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testCertificatesDataSourceConfig = `
+resource "kong_certificate" "test_certificate" {
+	certificate  = "public key --- 999 ----"
+	private_key  = "private key --- 000 ----"
+}
+
+data "kong_certificates" "certificates_data_source" {
+	depends_on = ["kong_certificate.test_certificate"]
+}
+`
+
+const testCertificatesDataSourceFilterConfig = `
+resource "kong_certificate" "test_certificate" {
+	certificate  = "public key --- 999 ----"
+	private_key  = "private key --- 000 ----"
+	snis         = ["filter.example.com"]
+}
+
+resource "kong_certificate" "other_certificate" {
+	certificate  = "public key --- 777 ----"
+	private_key  = "private key --- 666 ----"
+}
+
+data "kong_certificates" "by_sni" {
+	filter = {
+		sni = "filter.example.com"
+	}
+	depends_on = ["kong_certificate.test_certificate", "kong_certificate.other_certificate"]
+}
+
+data "kong_certificates" "by_id" {
+	filter = {
+		id = "${kong_certificate.other_certificate.id}"
+	}
+}
+
+data "kong_certificates" "no_match" {
+	filter = {
+		id  = "${kong_certificate.other_certificate.id}"
+		sni = "filter.example.com"
+	}
+	depends_on = ["kong_certificate.test_certificate"]
+}
+`
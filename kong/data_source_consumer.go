@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/kevholditch/gokong"
+	"sort"
 )
 
 func dataSourceKongConsumer() *schema.Resource {
@@ -42,6 +43,36 @@ func dataSourceKongConsumer() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"plugins": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"acl_groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"credential_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -81,5 +112,65 @@ func dataSourceKongConsumerRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("username", consumer.Username)
 	d.Set("custom_id", consumer.CustomId)
 
+	return readKongConsumerSummary(d, meta.(*gokong.KongAdminClient), consumer.Id)
+}
+
+// readKongConsumerSummary sets the plugins, acl groups and credential types of the consumer, credentials are only
+// checked for existence so no secrets end up in state
+func readKongConsumerSummary(d *schema.ResourceData, client *gokong.KongAdminClient, consumerId string) error {
+
+	plugins, err := client.Plugins().ListAllFiltered(&gokong.PluginFilter{ConsumerId: consumerId})
+
+	if err != nil {
+		return fmt.Errorf("could not find plugins for consumer: %v, error: %v", consumerId, err)
+	}
+
+	sort.Slice(plugins, func(i, j int) bool {
+		if plugins[i].Name != plugins[j].Name {
+			return plugins[i].Name < plugins[j].Name
+		}
+		return plugins[i].Id < plugins[j].Id
+	})
+
+	var pluginSummaries []map[string]interface{}
+	for _, plugin := range plugins {
+		pluginSummaries = append(pluginSummaries, map[string]interface{}{
+			"id":      plugin.Id,
+			"name":    plugin.Name,
+			"enabled": plugin.Enabled,
+		})
+	}
+
+	acls, err := client.Credentials().ListAcls(consumerId)
+
+	// the acls endpoint does not exist when the acl plugin is not installed
+	if err != nil && !gokong.IsNotFound(err) {
+		return fmt.Errorf("could not find acls for consumer: %v, error: %v", consumerId, err)
+	}
+
+	var aclGroups []string
+	for _, acl := range acls {
+		aclGroups = append(aclGroups, acl.Group)
+	}
+	sort.Strings(aclGroups)
+
+	var credentialTypes []string
+	for credentialType, path := range gokong.CredentialTypes {
+		found, err := client.Credentials().HasCredentials(consumerId, path)
+
+		if err != nil {
+			return fmt.Errorf("could not find %s credentials for consumer: %v, error: %v", credentialType, consumerId, err)
+		}
+
+		if found {
+			credentialTypes = append(credentialTypes, credentialType)
+		}
+	}
+	sort.Strings(credentialTypes)
+
+	d.Set("plugins", pluginSummaries)
+	d.Set("acl_groups", aclGroups)
+	d.Set("credential_types", credentialTypes)
+
 	return nil
 }
@@ -21,6 +21,31 @@ func TestAccDataSourceKongConsumer(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceKongConsumerSummary(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testConsumerSummaryDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kong_consumer.consumer_data_source", "username", "User888"),
+					resource.TestCheckResourceAttr("data.kong_consumer.consumer_data_source", "plugins.#", "1"),
+					resource.TestCheckResourceAttr("data.kong_consumer.consumer_data_source", "plugins.0.name", "response-ratelimiting"),
+					resource.TestCheckResourceAttr("data.kong_consumer.consumer_data_source", "plugins.0.enabled", "true"),
+					testAccCheckForChildIdCorrect("kong_plugin.rate_limit", "data.kong_consumer.consumer_data_source", "plugins.0.id"),
+					resource.TestCheckResourceAttr("data.kong_consumer.consumer_data_source", "acl_groups.#", "2"),
+					resource.TestCheckResourceAttr("data.kong_consumer.consumer_data_source", "acl_groups.0", "admins"),
+					resource.TestCheckResourceAttr("data.kong_consumer.consumer_data_source", "acl_groups.1", "readers"),
+					resource.TestCheckResourceAttr("data.kong_consumer.consumer_data_source", "credential_types.#", "2"),
+					resource.TestCheckResourceAttr("data.kong_consumer.consumer_data_source", "credential_types.0", "basic-auth"),
+					resource.TestCheckResourceAttr("data.kong_consumer.consumer_data_source", "credential_types.1", "key-auth"),
+				),
+			},
+		},
+	})
+}
+
 const testConsumerDataSourceConfig = `
 resource "kong_consumer" "test_consumer" {
 	username  = "User777"
@@ -35,3 +60,42 @@ data "kong_consumer" "consumer_data_source" {
 	}
 }
 `
+
+const testConsumerSummaryDataSourceConfig = `
+resource "kong_consumer" "test_consumer" {
+	username  = "User888"
+	custom_id = "888888"
+}
+
+resource "kong_plugin" "rate_limit" {
+	name        = "response-ratelimiting"
+	consumer_id = "${kong_consumer.test_consumer.id}"
+	config 		= {
+		limits.sms.minute = 20
+	}
+}
+
+resource "kong_consumer_acl" "acl" {
+	consumer_id = "${kong_consumer.test_consumer.id}"
+	groups      = ["readers", "admins"]
+}
+
+resource "kong_consumer_key_auth" "key_auth" {
+	consumer_id = "${kong_consumer.test_consumer.id}"
+	key         = "summary-secret-key"
+}
+
+resource "kong_consumer_basic_auth" "basic_auth" {
+	consumer_id = "${kong_consumer.test_consumer.id}"
+	username    = "summary-user"
+	password    = "summary-password"
+}
+
+data "kong_consumer" "consumer_data_source" {
+	filter = {
+		username = "${kong_consumer.test_consumer.username}"
+	}
+
+	depends_on = ["kong_plugin.rate_limit", "kong_consumer_acl.acl", "kong_consumer_key_auth.key_auth", "kong_consumer_basic_auth.basic_auth"]
+}
+`
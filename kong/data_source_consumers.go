@@ -0,0 +1,94 @@
+package kong
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kevholditch/gokong"
+)
+
+func dataSourceKongConsumers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKongConsumersRead,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"custom_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"consumers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"custom_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKongConsumersRead(d *schema.ResourceData, meta interface{}) error {
+
+	filterMap := readListDataSourceFilter(d)
+	filter := &gokong.ConsumerFilter{}
+
+	if v, ok := filterMap["username"]; ok {
+		filter.Username = v.(string)
+	}
+	if v, ok := filterMap["custom_id"]; ok {
+		filter.CustomId = v.(string)
+	}
+
+	results, err := meta.(*gokong.KongAdminClient).Consumers().ListAllFiltered(filter)
+
+	if err != nil {
+		return fmt.Errorf("could not list consumers, error: %v", err)
+	}
+
+	var ids []string
+	var consumers []map[string]interface{}
+
+	for _, consumer := range results {
+		ids = append(ids, consumer.Id)
+		consumers = append(consumers, map[string]interface{}{
+			"id":        consumer.Id,
+			"username":  consumer.Username,
+			"custom_id": consumer.CustomId,
+		})
+	}
+
+	d.SetId(listDataSourceId(ids))
+	d.Set("ids", ids)
+	d.Set("consumers", consumers)
+
+	return nil
+}
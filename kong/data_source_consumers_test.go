@@ -0,0 +1,91 @@
+package kong
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceKongConsumers(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testConsumersDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kong_consumers.consumers_data_source", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.kong_consumers.consumers_data_source", "consumers.0.username", "ConsumersUser1"),
+					resource.TestCheckResourceAttr("data.kong_consumers.consumers_data_source", "consumers.0.custom_id", "consumers-1"),
+					resource.TestCheckResourceAttr("data.kong_consumers.all_consumers_data_source", "ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceKongConsumersPaginated(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testConsumersPaginatedDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kong_consumers.all_consumers_data_source", "ids.#", "3"),
+					resource.TestCheckResourceAttr("data.kong_consumer.last_consumer_data_source", "username", "PagedUser3"),
+				),
+			},
+		},
+	})
+}
+
+const testConsumersDataSourceConfig = `
+resource "kong_consumer" "consumer1" {
+	username  = "ConsumersUser1"
+	custom_id = "consumers-1"
+}
+
+resource "kong_consumer" "consumer2" {
+	username  = "ConsumersUser2"
+	custom_id = "consumers-2"
+}
+
+data "kong_consumers" "consumers_data_source" {
+	filter = {
+		custom_id = "${kong_consumer.consumer1.custom_id}"
+	}
+}
+
+data "kong_consumers" "all_consumers_data_source" {
+	depends_on = ["kong_consumer.consumer1", "kong_consumer.consumer2"]
+}
+`
+
+const testConsumersPaginatedDataSourceConfig = `
+provider "kong" {
+	page_size = 1
+}
+
+resource "kong_consumer" "consumer1" {
+	username  = "PagedUser1"
+}
+
+resource "kong_consumer" "consumer2" {
+	username  = "PagedUser2"
+}
+
+resource "kong_consumer" "consumer3" {
+	username  = "PagedUser3"
+	custom_id = "paged-3"
+}
+
+data "kong_consumers" "all_consumers_data_source" {
+	depends_on = ["kong_consumer.consumer1", "kong_consumer.consumer2", "kong_consumer.consumer3"]
+}
+
+data "kong_consumer" "last_consumer_data_source" {
+	filter = {
+		custom_id = "${kong_consumer.consumer3.custom_id}"
+	}
+}
+`
@@ -0,0 +1,26 @@
+package kong
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"strings"
+)
+
+// readListDataSourceFilter returns the values of the optional filter block of a list data source
+func readListDataSourceFilter(d *schema.ResourceData) map[string]interface{} {
+
+	if v, _ := d.GetOk("filter"); v != nil {
+		filterSet := v.(*schema.Set).List()
+		if len(filterSet) == 1 && filterSet[0] != nil {
+			return filterSet[0].(map[string]interface{})
+		}
+	}
+
+	return map[string]interface{}{}
+}
+
+// listDataSourceId identifies the result of a list data source by the ids it found
+func listDataSourceId(ids []string) string {
+	return fmt.Sprintf("%d", hashcode.String(strings.Join(ids, ",")))
+}
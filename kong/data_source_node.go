@@ -0,0 +1,159 @@
+package kong
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kevholditch/gokong"
+	"sort"
+)
+
+func dataSourceKongNode() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKongNodeRead,
+		Schema: map[string]*schema.Schema{
+			"node_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"lua_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tagline": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"available_plugins": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"enabled_plugins": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"database": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"proxy_listen": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"proxy_listen_ssl": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"admin_listen": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"admin_listen_ssl": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"database_reachable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"total_requests": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"connections_active": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"connections_accepted": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"connections_handled": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"connections_reading": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"connections_writing": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"connections_waiting": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceKongNodeRead(d *schema.ResourceData, meta interface{}) error {
+
+	client := meta.(*gokong.KongAdminClient)
+
+	nodeInfo, err := client.Info().Get()
+
+	if err != nil {
+		return fmt.Errorf("could not read kong node info, error: %v", err)
+	}
+
+	status, err := client.Status().Get()
+
+	if err != nil {
+		return fmt.Errorf("could not read kong node status, error: %v", err)
+	}
+
+	var availablePlugins []string
+	for name, available := range nodeInfo.Plugins.AvailableOnServer {
+		if available {
+			availablePlugins = append(availablePlugins, name)
+		}
+	}
+	sort.Strings(availablePlugins)
+
+	enabledPlugins := []string(nodeInfo.Plugins.EnabledInCluster)
+	sort.Strings(enabledPlugins)
+
+	if nodeInfo.NodeId != "" {
+		d.SetId(nodeInfo.NodeId)
+	} else {
+		d.SetId(nodeInfo.Hostname)
+	}
+
+	d.Set("node_id", nodeInfo.NodeId)
+	d.Set("version", nodeInfo.Version)
+	d.Set("hostname", nodeInfo.Hostname)
+	d.Set("lua_version", nodeInfo.LuaVersion)
+	d.Set("tagline", nodeInfo.Tagline)
+	d.Set("available_plugins", availablePlugins)
+	d.Set("enabled_plugins", enabledPlugins)
+	d.Set("database", nodeInfo.Configuration.Database)
+	d.Set("proxy_listen", []string(nodeInfo.Configuration.ProxyListen))
+	d.Set("proxy_listen_ssl", []string(nodeInfo.Configuration.ProxyListenSsl))
+	d.Set("admin_listen", []string(nodeInfo.Configuration.AdminListen))
+	d.Set("admin_listen_ssl", []string(nodeInfo.Configuration.AdminListenSsl))
+	d.Set("database_reachable", status.Database.Reachable)
+	d.Set("total_requests", status.Server.TotalRequests)
+	d.Set("connections_active", status.Server.ConnectionsActive)
+	d.Set("connections_accepted", status.Server.ConnectionsAccepted)
+	d.Set("connections_handled", status.Server.ConnectionsHandled)
+	d.Set("connections_reading", status.Server.ConnectionsReading)
+	d.Set("connections_writing", status.Server.ConnectionsWriting)
+	d.Set("connections_waiting", status.Server.ConnectionsWaiting)
+
+	return nil
+}
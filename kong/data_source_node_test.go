@@ -0,0 +1,33 @@
+package kong
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccDataSourceKongNode(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testNodeDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.kong_node.node", "version", regexp.MustCompile("^"+regexp.QuoteMeta(GetEnvVarOrDefault("KONG_VERSION", defaultKongVersion)))),
+					resource.TestCheckResourceAttrSet("data.kong_node.node", "hostname"),
+					resource.TestCheckResourceAttrSet("data.kong_node.node", "lua_version"),
+					resource.TestCheckResourceAttr("data.kong_node.node", "database", "postgres"),
+					resource.TestCheckResourceAttr("data.kong_node.node", "database_reachable", "true"),
+					resource.TestCheckResourceAttrSet("data.kong_node.node", "admin_listen.0"),
+					resource.TestCheckResourceAttrSet("data.kong_node.node", "available_plugins.#"),
+					resource.TestCheckResourceAttrSet("data.kong_node.node", "total_requests"),
+				),
+			},
+		},
+	})
+}
+
+const testNodeDataSourceConfig = `
+data "kong_node" "node" {}
+`
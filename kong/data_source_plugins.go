@@ -0,0 +1,111 @@
+package kong
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kevholditch/gokong"
+)
+
+func dataSourceKongPlugins() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKongPluginsRead,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"api_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"consumer_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"plugins": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"api_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"consumer_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKongPluginsRead(d *schema.ResourceData, meta interface{}) error {
+
+	filterMap := readListDataSourceFilter(d)
+	filter := &gokong.PluginFilter{}
+
+	if v, ok := filterMap["name"]; ok {
+		filter.Name = v.(string)
+	}
+	if v, ok := filterMap["api_id"]; ok {
+		filter.ApiId = v.(string)
+	}
+	if v, ok := filterMap["consumer_id"]; ok {
+		filter.ConsumerId = v.(string)
+	}
+
+	results, err := meta.(*gokong.KongAdminClient).Plugins().ListAllFiltered(filter)
+
+	if err != nil {
+		return fmt.Errorf("could not list plugins, error: %v", err)
+	}
+
+	var ids []string
+	var plugins []map[string]interface{}
+
+	for _, plugin := range results {
+		ids = append(ids, plugin.Id)
+		plugins = append(plugins, map[string]interface{}{
+			"id":          plugin.Id,
+			"name":        plugin.Name,
+			"api_id":      plugin.ApiId,
+			"consumer_id": plugin.ConsumerId,
+			"enabled":     plugin.Enabled,
+		})
+	}
+
+	d.SetId(listDataSourceId(ids))
+	d.Set("ids", ids)
+	d.Set("plugins", plugins)
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package kong
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceKongPlugins(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testPluginsDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kong_plugins.plugins_data_source", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.kong_plugins.plugins_data_source", "plugins.#", "2"),
+					resource.TestCheckResourceAttr("data.kong_plugins.rate_limiting_data_source", "plugins.#", "1"),
+					resource.TestCheckResourceAttr("data.kong_plugins.rate_limiting_data_source", "plugins.0.name", "rate-limiting"),
+					resource.TestCheckResourceAttr("data.kong_plugins.rate_limiting_data_source", "plugins.0.enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+const testPluginsDataSourceConfig = `
+resource "kong_api" "api" {
+	name 	     = "TestPluginsDataSourceApi"
+	hosts        = [ "example.com" ]
+	upstream_url = "http://localhost:4140"
+}
+
+resource "kong_plugin" "rate_limit" {
+	name   = "rate-limiting"
+	api_id = "${kong_api.api.id}"
+	config = {
+		second = 10
+	}
+}
+
+resource "kong_plugin" "cors" {
+	name   = "cors"
+	api_id = "${kong_api.api.id}"
+}
+
+data "kong_plugins" "plugins_data_source" {
+	filter = {
+		api_id = "${kong_api.api.id}"
+	}
+	depends_on = ["kong_plugin.rate_limit", "kong_plugin.cors"]
+}
+
+data "kong_plugins" "rate_limiting_data_source" {
+	filter = {
+		name   = "${kong_plugin.rate_limit.name}"
+		api_id = "${kong_api.api.id}"
+	}
+}
+`
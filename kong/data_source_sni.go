@@ -0,0 +1,64 @@
+package kong
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kevholditch/gokong"
+)
+
+func dataSourceKongSni() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKongSniRead,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceKongSniRead(d *schema.ResourceData, meta interface{}) error {
+
+	var filterName string
+
+	if v, _ := d.GetOk("filter"); v != nil {
+		filterSet := v.(*schema.Set).List()
+		if len(filterSet) == 1 {
+			filterMap := filterSet[0].(map[string]interface{})
+			filterName = filterMap["name"].(string)
+		}
+	}
+
+	result, err := meta.(*gokong.KongAdminClient).Snis().GetByName(filterName)
+
+	if err != nil {
+		return fmt.Errorf("could not find sni, error: %v", err)
+	}
+
+	if result == nil {
+		return fmt.Errorf("could not find sni by name: %v", filterName)
+	}
+
+	d.SetId(result.Name)
+	d.Set("name", result.Name)
+	d.Set("certificate_id", result.SslCertificateId)
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package kong
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceKongSni(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testSniDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kong_sni.sni_data_source", "name", "data.example.com"),
+					testAccCheckForChildIdCorrect("kong_certificate.certificate", "data.kong_sni.sni_data_source", "certificate_id"),
+				),
+			},
+		},
+	})
+}
+
+const testSniDataSourceConfig = `
+resource "kong_certificate" "certificate" {
+	certificate  = "public key --- 123 ----"
+	private_key  = "private key --- 456 ----"
+}
+
+resource "kong_sni" "sni" {
+	name           = "data.example.com"
+	certificate_id = "${kong_certificate.certificate.id}"
+}
+
+data "kong_sni" "sni_data_source" {
+	filter = {
+		name = "${kong_sni.sni.name}"
+	}
+}
+`
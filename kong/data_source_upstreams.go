@@ -0,0 +1,100 @@
+package kong
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kevholditch/gokong"
+)
+
+func dataSourceKongUpstreams() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKongUpstreamsRead,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"slots": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"upstreams": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"slots": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"order_list": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKongUpstreamsRead(d *schema.ResourceData, meta interface{}) error {
+
+	filterMap := readListDataSourceFilter(d)
+	filter := &gokong.UpstreamFilter{}
+
+	if v, ok := filterMap["name"]; ok {
+		filter.Name = v.(string)
+	}
+	if v, ok := filterMap["slots"]; ok {
+		filter.Slots = v.(int)
+	}
+
+	results, err := meta.(*gokong.KongAdminClient).Upstreams().ListAllFiltered(filter)
+
+	if err != nil {
+		return fmt.Errorf("could not list upstreams, error: %v", err)
+	}
+
+	var ids []string
+	var upstreams []map[string]interface{}
+
+	for _, upstream := range results {
+		ids = append(ids, upstream.Id)
+		upstreams = append(upstreams, map[string]interface{}{
+			"id":         upstream.Id,
+			"name":       upstream.Name,
+			"slots":      upstream.Slots,
+			"order_list": upstream.OrderList,
+		})
+	}
+
+	d.SetId(listDataSourceId(ids))
+	d.Set("ids", ids)
+	d.Set("upstreams", upstreams)
+
+	return nil
+}
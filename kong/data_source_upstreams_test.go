@@ -0,0 +1,49 @@
+package kong
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceKongUpstreams(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testUpstreamsDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kong_upstreams.upstreams_data_source", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.kong_upstreams.upstreams_data_source", "upstreams.0.slots", "20"),
+					resource.TestCheckResourceAttr("data.kong_upstreams.named_upstreams_data_source", "upstreams.#", "1"),
+					resource.TestCheckResourceAttr("data.kong_upstreams.named_upstreams_data_source", "upstreams.0.name", "TestUpstreams1"),
+				),
+			},
+		},
+	})
+}
+
+const testUpstreamsDataSourceConfig = `
+resource "kong_upstream" "upstream1" {
+	name  = "TestUpstreams1"
+	slots = 20
+}
+
+resource "kong_upstream" "upstream2" {
+	name  = "TestUpstreams2"
+	slots = 20
+}
+
+data "kong_upstreams" "upstreams_data_source" {
+	filter = {
+		slots = 20
+	}
+	depends_on = ["kong_upstream.upstream1", "kong_upstream.upstream2"]
+}
+
+data "kong_upstreams" "named_upstreams_data_source" {
+	filter = {
+		name = "${kong_upstream.upstream1.name}"
+	}
+}
+`
@@ -0,0 +1,76 @@
+package kong
+
+import (
+	"fmt"
+	"github.com/hashicorp/go-version"
+	"github.com/kevholditch/gokong"
+	"regexp"
+)
+
+// the kong versions that added or removed features, differences between kong versions are checked against these
+const (
+	kongHealthChecksVersion = "0.12.0"
+	kongServicesVersion     = "0.13.0"
+	kongApisRemovedVersion  = "1.0.0"
+)
+
+var kongVersionPattern = regexp.MustCompile(`^\d+(\.\d+)*`)
+
+// readKongVersion returns the version of the kong node detected when the provider was configured, nil if it is not known
+func readKongVersion(meta interface{}) *version.Version {
+
+	nodeInfo := meta.(*gokong.KongAdminClient).NodeInfo()
+
+	if nodeInfo == nil {
+		return nil
+	}
+
+	// enterprise and release candidate versions carry a suffix, only the numeric part is compared
+	kongVersion, err := version.NewVersion(kongVersionPattern.FindString(nodeInfo.Version))
+
+	if err != nil {
+		return nil
+	}
+
+	return kongVersion
+}
+
+// requireKongVersion returns an error when the feature was added after the detected kong version
+func requireKongVersion(meta interface{}, feature string, minimumVersion string) error {
+
+	kongVersion := readKongVersion(meta)
+
+	if kongVersion != nil && kongVersion.LessThan(version.Must(version.NewVersion(minimumVersion))) {
+		return fmt.Errorf("%s requires kong %s or later, the kong node is running %s", feature, minimumVersion, kongVersion)
+	}
+
+	return nil
+}
+
+// rejectRemovedInKongVersion returns an error when the feature was removed in or before the detected kong version
+func rejectRemovedInKongVersion(meta interface{}, feature string, removedVersion string) error {
+
+	kongVersion := readKongVersion(meta)
+
+	if kongVersion != nil && !kongVersion.LessThan(version.Must(version.NewVersion(removedVersion))) {
+		return fmt.Errorf("%s was removed in kong %s, the kong node is running %s", feature, removedVersion, kongVersion)
+	}
+
+	return nil
+}
+
+// requireKongPlugin returns an error when the plugin is not installed on the kong node
+func requireKongPlugin(meta interface{}, pluginName string) error {
+
+	nodeInfo := meta.(*gokong.KongAdminClient).NodeInfo()
+
+	if nodeInfo == nil || len(nodeInfo.Plugins.AvailableOnServer) == 0 {
+		return nil
+	}
+
+	if !nodeInfo.Plugins.AvailableOnServer[pluginName] {
+		return fmt.Errorf("the %s plugin is not available on the kong node", pluginName)
+	}
+
+	return nil
+}
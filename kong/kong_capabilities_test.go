@@ -0,0 +1,74 @@
+package kong
+
+import (
+	"fmt"
+	"github.com/kevholditch/gokong"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func testKongClientForNode(t *testing.T, nodeInfo string) (*gokong.KongAdminClient, *httptest.Server) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, nodeInfo)
+	}))
+
+	client := gokong.NewClient(&gokong.Config{HostAddress: server.URL})
+
+	if _, err := client.LoadNodeInfo(); err != nil {
+		server.Close()
+		t.Fatalf("could not load node info: %v", err)
+	}
+
+	return client, server
+}
+
+func TestKongVersionChecks(t *testing.T) {
+
+	client, server := testKongClientForNode(t, `{"version": "0.12.3", "plugins": {"available_on_server": {"key-auth": true}, "enabled_in_cluster": {}}}`)
+	defer server.Close()
+
+	if err := requireKongVersion(client, "healthchecks", kongHealthChecksVersion); err != nil {
+		t.Fatalf("expected health checks to be supported: %v", err)
+	}
+
+	if err := requireKongVersion(client, "kong_service", kongServicesVersion); err == nil {
+		t.Fatalf("expected kong_service to be rejected on kong 0.12")
+	}
+
+	if err := rejectRemovedInKongVersion(client, "kong_api", kongApisRemovedVersion); err != nil {
+		t.Fatalf("expected kong_api to be supported: %v", err)
+	}
+
+	if err := requireKongPlugin(client, "key-auth"); err != nil {
+		t.Fatalf("expected key-auth to be available: %v", err)
+	}
+
+	if err := requireKongPlugin(client, "jwt"); err == nil {
+		t.Fatalf("expected jwt to be rejected when it is not installed")
+	}
+}
+
+func TestKongVersionChecksWithVersionSuffix(t *testing.T) {
+
+	client, server := testKongClientForNode(t, `{"version": "1.0.0rc1", "plugins": {"available_on_server": {}, "enabled_in_cluster": ["acl"]}}`)
+	defer server.Close()
+
+	if err := rejectRemovedInKongVersion(client, "kong_api", kongApisRemovedVersion); err == nil {
+		t.Fatalf("expected kong_api to be rejected on kong 1.0")
+	}
+
+	if err := requireKongPlugin(client, "acl"); err != nil {
+		t.Fatalf("expected plugin checks to be skipped when the node does not list its plugins: %v", err)
+	}
+}
+
+func TestKongVersionChecksWithoutNodeInfo(t *testing.T) {
+
+	client := gokong.NewClient(&gokong.Config{HostAddress: "http://localhost:1"})
+
+	if err := requireKongVersion(client, "kong_service", kongServicesVersion); err != nil {
+		t.Fatalf("expected version checks to be skipped when the version is unknown: %v", err)
+	}
+}
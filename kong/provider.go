@@ -1,10 +1,14 @@
 package kong
 
 import (
+	"fmt"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/kevholditch/gokong"
+	"log"
 	"os"
+	"strings"
+	"time"
 )
 
 func Provider() terraform.ResourceProvider {
@@ -16,23 +20,133 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: envDefaultFuncWithDefault("KONG_ADMIN_ADDR", "http://localhost:8001"),
 				Description: "The address of the kong admin url e.g. http://localhost:8001",
 			},
+			"kong_admin_username": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KONG_ADMIN_USERNAME", ""),
+				Description: "The username for basic auth against the kong admin api",
+			},
+			"kong_admin_password": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("KONG_ADMIN_PASSWORD", ""),
+				Description: "The password for basic auth against the kong admin api",
+			},
+			"kong_admin_token": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("KONG_ADMIN_TOKEN", ""),
+				Description: "A token sent to the kong admin api in the kong_admin_token_header header e.g. an RBAC token",
+			},
+			"kong_admin_token_header": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KONG_ADMIN_TOKEN_HEADER", gokong.DefaultAdminTokenHeader),
+				Description: "The name of the header the kong_admin_token is sent in",
+			},
+			"tls_skip_verify": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KONG_ADMIN_TLS_SKIP_VERIFY", false),
+				Description: "Whether to skip verification of the kong admin api certificate",
+			},
+			"ca_cert_file": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("KONG_ADMIN_CA_CERT_FILE", ""),
+				ConflictsWith: []string{"ca_cert"},
+				Description:   "The path to a pem file of the certificate authorities trusted for the kong admin api",
+			},
+			"ca_cert": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("KONG_ADMIN_CA_CERT", ""),
+				ConflictsWith: []string{"ca_cert_file"},
+				Description:   "The pem encoded certificate authorities trusted for the kong admin api",
+			},
+			"client_cert": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KONG_ADMIN_CLIENT_CERT", ""),
+				Description: "The pem encoded client certificate, or the path to it, presented to the kong admin api",
+			},
+			"client_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("KONG_ADMIN_CLIENT_KEY", ""),
+				Description: "The pem encoded private key of the client certificate, or the path to it",
+			},
+			"max_retries": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KONG_ADMIN_MAX_RETRIES", 3),
+				Description: "How many times a read, update or delete is retried when kong cannot be reached or returns a 5xx or 429",
+			},
+			"retry_backoff": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KONG_ADMIN_RETRY_BACKOFF", 1),
+				Description: "The number of seconds to wait before the first retry, the wait grows exponentially with each retry",
+			},
+			"request_timeout": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KONG_ADMIN_REQUEST_TIMEOUT", 0),
+				Description: "The number of seconds to wait for a response from the kong admin api, 0 waits forever",
+			},
+			"page_size": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KONG_ADMIN_PAGE_SIZE", 100),
+				Description: "The number of entities requested in each page when listing entities, kong allows between 1 and 1000",
+				ValidateFunc: func(value interface{}, key string) ([]string, []error) {
+					if size := value.(int); size < 1 || size > 1000 {
+						return nil, []error{fmt.Errorf("%s must be between 1 and 1000", key)}
+					}
+					return nil, nil
+				},
+			},
+			"headers": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Extra headers sent with every request to the kong admin api, read from KONG_ADMIN_HEADERS as name=value pairs separated by commas when not set",
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"kong_api":         resourceKongApi(),
-			"kong_certificate": resourceKongCertificate(),
-			"kong_consumer":    resourceKongConsumer(),
-			"kong_plugin":      resourceKongPlugin(),
-			"kong_sni":         resourceKongSni(),
-			"kong_upstream":    resourceKongUpstream(),
+			"kong_api":                 resourceKongApi(),
+			"kong_certificate":         resourceKongCertificate(),
+			"kong_consumer":            resourceKongConsumer(),
+			"kong_consumer_acl":        resourceKongConsumerAcl(),
+			"kong_consumer_basic_auth": resourceKongConsumerBasicAuth(),
+			"kong_consumer_hmac_auth":  resourceKongConsumerHmacAuth(),
+			"kong_consumer_jwt":        resourceKongConsumerJwt(),
+			"kong_consumer_key_auth":   resourceKongConsumerKeyAuth(),
+			"kong_consumer_oauth2":     resourceKongConsumerOauth2(),
+			"kong_plugin":              resourceKongPlugin(),
+			"kong_route":               resourceKongRoute(),
+			"kong_service":             resourceKongService(),
+			"kong_sni":                 resourceKongSni(),
+			"kong_target":              resourceKongTarget(),
+			"kong_upstream":            resourceKongUpstream(),
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"kong_api":         dataSourceKongApi(),
-			"kong_certificate": dataSourceKongCertificate(),
-			"kong_consumer":    dataSourceKongConsumer(),
-			"kong_plugin":      dataSourceKongPlugin(),
-			"kong_upstream":    dataSourceKongUpstream(),
+			"kong_api":          dataSourceKongApi(),
+			"kong_apis":         dataSourceKongApis(),
+			"kong_certificate":  dataSourceKongCertificate(),
+			"kong_certificates": dataSourceKongCertificates(),
+			"kong_consumer":     dataSourceKongConsumer(),
+			"kong_consumers":    dataSourceKongConsumers(),
+			"kong_node":         dataSourceKongNode(),
+			"kong_plugin":       dataSourceKongPlugin(),
+			"kong_plugins":      dataSourceKongPlugins(),
+			"kong_sni":          dataSourceKongSni(),
+			"kong_upstream":     dataSourceKongUpstream(),
+			"kong_upstreams":    dataSourceKongUpstreams(),
 		},
 		ConfigureFunc: providerConfigure,
 	}
@@ -53,9 +167,52 @@ func envDefaultFuncWithDefault(key string, defaultValue string) schema.SchemaDef
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+	httpClient, err := createKongHttpClient(d)
+	if err != nil {
+		return nil, err
+	}
+
 	config := &gokong.Config{
-		HostAddress: d.Get("kong_admin_uri").(string),
+		HostAddress:      d.Get("kong_admin_uri").(string),
+		Username:         d.Get("kong_admin_username").(string),
+		Password:         d.Get("kong_admin_password").(string),
+		AdminToken:       d.Get("kong_admin_token").(string),
+		AdminTokenHeader: d.Get("kong_admin_token_header").(string),
+		Headers:          readProviderHeaders(d),
+		HttpClient:       httpClient,
+		MaxRetries:       d.Get("max_retries").(int),
+		RetryBackoff:     time.Duration(d.Get("retry_backoff").(int)) * time.Second,
+		RequestTimeout:   time.Duration(d.Get("request_timeout").(int)) * time.Second,
+		PageSize:         d.Get("page_size").(int),
+	}
+
+	client := gokong.NewClient(config)
+
+	// the version and plugins of the node are read once so resources can reject settings the node does not support,
+	// if they cannot be read the checks are skipped and kong reports any problem itself
+	if _, err := client.LoadNodeInfo(); err != nil {
+		log.Printf("[WARN] could not read the kong version from %s, version checks are disabled: %v", config.HostAddress, err)
+	}
+
+	return client, nil
+}
+
+func readProviderHeaders(d *schema.ResourceData) map[string]string {
+
+	headers := map[string]string{}
+
+	if attr, ok := d.GetOk("headers"); ok {
+		for name, value := range attr.(map[string]interface{}) {
+			headers[name] = value.(string)
+		}
+		return headers
+	}
+
+	for _, pair := range strings.Split(os.Getenv("KONG_ADMIN_HEADERS"), ",") {
+		if parts := strings.SplitN(pair, "=", 2); len(parts) == 2 && strings.TrimSpace(parts[0]) != "" {
+			headers[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+		}
 	}
 
-	return gokong.NewClient(config), nil
+	return headers
 }
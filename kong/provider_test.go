@@ -1,11 +1,13 @@
 package kong
 
 import (
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/kevholditch/gokong"
 	"github.com/kevholditch/gokong/containers"
 	"log"
+	"net/http"
 	"os"
 	"testing"
 )
@@ -32,6 +34,70 @@ func TestProvider_impl(t *testing.T) {
 	var _ terraform.ResourceProvider = Provider()
 }
 
+func TestProviderHeadersFromEnv(t *testing.T) {
+	os.Setenv("KONG_ADMIN_HEADERS", "apikey=my-key, X-Team = platform")
+	defer os.Unsetenv("KONG_ADMIN_HEADERS")
+
+	d := schema.TestResourceDataRaw(t, Provider().(*schema.Provider).Schema, map[string]interface{}{})
+	headers := readProviderHeaders(d)
+
+	if len(headers) != 2 || headers["apikey"] != "my-key" || headers["X-Team"] != "platform" {
+		t.Fatalf("unexpected headers read from env: %v", headers)
+	}
+}
+
+func TestProviderHeadersFromConfig(t *testing.T) {
+	os.Setenv("KONG_ADMIN_HEADERS", "apikey=my-key")
+	defer os.Unsetenv("KONG_ADMIN_HEADERS")
+
+	d := schema.TestResourceDataRaw(t, Provider().(*schema.Provider).Schema, map[string]interface{}{
+		"headers": map[string]interface{}{"X-Team": "platform"},
+	})
+	headers := readProviderHeaders(d)
+
+	if len(headers) != 1 || headers["X-Team"] != "platform" {
+		t.Fatalf("expected headers from config to replace env headers: %v", headers)
+	}
+}
+
+func TestProviderHttpClientSkipVerify(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, Provider().(*schema.Provider).Schema, map[string]interface{}{
+		"tls_skip_verify": true,
+	})
+
+	client, err := createKongHttpClient(d)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if !client.Transport.(*http.Transport).TLSClientConfig.InsecureSkipVerify {
+		t.Fatalf("expected tls verification to be skipped")
+	}
+}
+
+func TestProviderHttpClientInvalidTlsSettings(t *testing.T) {
+	invalidSettings := []map[string]interface{}{
+		{"ca_cert": "not a certificate"},
+		{"ca_cert_file": "/does/not/exist.pem"},
+		{"client_cert": "-----BEGIN CERTIFICATE-----"},
+	}
+
+	for _, settings := range invalidSettings {
+		d := schema.TestResourceDataRaw(t, Provider().(*schema.Provider).Schema, settings)
+
+		if _, err := createKongHttpClient(d); err == nil {
+			t.Fatalf("expected an error for tls settings %v", settings)
+		}
+	}
+}
+
+func testAccSkipBelowKongVersion(t *testing.T, minimumVersion string) {
+	current := version.Must(version.NewVersion(GetEnvVarOrDefault("KONG_VERSION", defaultKongVersion)))
+	if current.LessThan(version.Must(version.NewVersion(minimumVersion))) {
+		t.Skipf("skipping as kong %s is older than %s", current, minimumVersion)
+	}
+}
+
 func TestMain(m *testing.M) {
 
 	testContext := containers.StartKong(GetEnvVarOrDefault("KONG_VERSION", defaultKongVersion))
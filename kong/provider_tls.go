@@ -0,0 +1,81 @@
+package kong
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// createKongHttpClient builds the http client shared by every request to the admin api from the tls settings of the provider
+func createKongHttpClient(d *schema.ResourceData) (*http.Client, error) {
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: d.Get("tls_skip_verify").(bool),
+	}
+
+	caCert := d.Get("ca_cert").(string)
+
+	if caCertFile := d.Get("ca_cert_file").(string); caCertFile != "" {
+		contents, err := ioutil.ReadFile(caCertFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not read ca_cert_file %s: %v", caCertFile, err)
+		}
+		caCert = string(contents)
+	}
+
+	if caCert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(caCert)) {
+			return nil, fmt.Errorf("no pem encoded certificates could be read from the ca certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	clientCert, err := readPemOrFile(d.Get("client_cert").(string))
+	if err != nil {
+		return nil, fmt.Errorf("could not read client_cert: %v", err)
+	}
+
+	clientKey, err := readPemOrFile(d.Get("client_key").(string))
+	if err != nil {
+		return nil, fmt.Errorf("could not read client_key: %v", err)
+	}
+
+	if clientCert != "" || clientKey != "" {
+		if clientCert == "" || clientKey == "" {
+			return nil, fmt.Errorf("client_cert and client_key must be set together")
+		}
+
+		certificate, err := tls.X509KeyPair([]byte(clientCert), []byte(clientKey))
+		if err != nil {
+			return nil, fmt.Errorf("could not load client certificate: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsConfig,
+		},
+	}, nil
+}
+
+// readPemOrFile returns the value when it is pem encoded, otherwise the value is treated as the path of a pem file
+func readPemOrFile(value string) (string, error) {
+
+	if value == "" || strings.HasPrefix(strings.TrimSpace(value), "-----BEGIN") {
+		return value, nil
+	}
+
+	contents, err := ioutil.ReadFile(value)
+	if err != nil {
+		return "", err
+	}
+
+	return string(contents), nil
+}
@@ -12,6 +12,9 @@ func resourceKongApi() *schema.Resource {
 		Read:   resourceKongApiRead,
 		Delete: resourceKongApiDelete,
 		Update: resourceKongApiUpdate,
+		Importer: &schema.ResourceImporter{
+			State: resourceKongApiImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -95,6 +98,10 @@ func resourceKongApi() *schema.Resource {
 
 func resourceKongApiCreate(d *schema.ResourceData, meta interface{}) error {
 
+	if err := rejectRemovedInKongVersion(meta, "kong_api", kongApisRemovedVersion); err != nil {
+		return err
+	}
+
 	apiRequest := createKongApiRequestFromResourceData(d)
 
 	api, err := meta.(*gokong.KongAdminClient).Apis().Create(apiRequest)
@@ -130,6 +137,11 @@ func resourceKongApiRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("could not find kong api: %v", err)
 	}
 
+	if api == nil {
+		d.SetId("")
+		return nil
+	}
+
 	d.Set("name", api.Name)
 	d.Set("hosts", api.Hosts)
 	d.Set("uris", api.Uris)
@@ -151,13 +163,30 @@ func resourceKongApiDelete(d *schema.ResourceData, meta interface{}) error {
 
 	err := meta.(*gokong.KongAdminClient).Apis().DeleteById(d.Id())
 
-	if err != nil {
+	if err != nil && !gokong.IsNotFound(err) {
 		return fmt.Errorf("could not delete kong api: %v", err)
 	}
 
 	return nil
 }
 
+func resourceKongApiImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+
+	api, err := meta.(*gokong.KongAdminClient).Apis().GetByName(d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("could not import kong api: %v", err)
+	}
+
+	if api == nil {
+		return nil, fmt.Errorf("could not find kong api with id or name: %s", d.Id())
+	}
+
+	d.SetId(api.Id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func createKongApiRequestFromResourceData(d *schema.ResourceData) *gokong.ApiRequest {
 
 	apiRequest := &gokong.ApiRequest{}
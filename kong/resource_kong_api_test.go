@@ -57,6 +57,52 @@ func TestAccKongApi(t *testing.T) {
 	})
 }
 
+func TestAccKongApiImport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongApiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateApiConfig,
+			},
+			{
+				ResourceName:      "kong_api.api",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "kong_api.api",
+				ImportStateId:     "TestApi",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKongApiDeletedOutsideTerraform(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongApiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateApiConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongApiExists("kong_api.api"),
+					testAccDeleteKongApi("kong_api.api"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testCreateApiConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongApiExists("kong_api.api"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckKongApiDestroy(state *terraform.State) error {
 
 	client := testAccProvider.Meta().(*gokong.KongAdminClient)
@@ -107,6 +153,19 @@ func testAccCheckKongApiExists(resourceKey string) resource.TestCheckFunc {
 	}
 }
 
+func testAccDeleteKongApi(resourceKey string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		return testAccProvider.Meta().(*gokong.KongAdminClient).Apis().DeleteById(rs.Primary.ID)
+	}
+}
+
 const testCreateApiConfig = `
 resource "kong_api" "api" {
 	name 	= "TestApi"
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/kevholditch/gokong"
+	"log"
+	"time"
 )
 
 func resourceKongCertificate() *schema.Resource {
@@ -12,17 +14,72 @@ func resourceKongCertificate() *schema.Resource {
 		Read:   resourceKongCertificateRead,
 		Delete: resourceKongCertificateDelete,
 		Update: resourceKongCertificateUpdate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"certificate": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: false,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     false,
+				StateFunc:    normalizePemStateFunc,
+				ValidateFunc: validateCertificateNotExpired,
 			},
+			// only a hash of the private key is kept in state, the key itself is read from the configuration on apply
 			"private_key": &schema.Schema{
-				Type:     schema.TypeString,
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  false,
+				Sensitive: true,
+				StateFunc: hashPrivateKeyStateFunc,
+			},
+			// snis conflicts with kong_sni resources for the same certificate, the snis are only tracked when they are set
+			"snis": &schema.Schema{
+				Type:     schema.TypeSet,
 				Optional: true,
 				ForceNew: false,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"min_days_remaining": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: false,
+				Default:  0,
+			},
+			"days_remaining": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"not_before": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"not_after": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subject": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"issuer": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dns_names": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"serial_number": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fingerprint_sha256": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 		},
 	}
@@ -32,41 +89,127 @@ func resourceKongCertificateCreate(d *schema.ResourceData, meta interface{}) err
 
 	certificateRequest := createKongCertificateRequestFromResourceData(d)
 
-	certificate, err := meta.(*gokong.KongAdminClient).Certificates().Create(certificateRequest)
+	if err := validateKongCertificateRequest(d, certificateRequest); err != nil {
+		return err
+	}
+
+	client := meta.(*gokong.KongAdminClient)
+
+	// the snis are added after the certificate is created so names bound to another certificate are reported by name
+	snis := certificateRequest.Snis
+	certificateRequest.Snis = nil
+
+	certificate, err := client.Certificates().Create(certificateRequest)
 
 	if err != nil {
-		return fmt.Errorf("failed to create kong certificate: %v error: %v", certificateRequest, err)
+		// the request is left out of the error as it holds the private key
+		return fmt.Errorf("failed to create kong certificate: %v", err)
 	}
 
 	d.SetId(certificate.Id)
 
+	if len(snis) > 0 {
+		if err := reconcileKongCertificateSnis(client, certificate.Id, snis); err != nil {
+			return fmt.Errorf("failed to create kong certificate snis: %v error: %v", snis, err)
+		}
+	}
+
 	return resourceKongCertificateRead(d, meta)
 }
 
 func resourceKongCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
 	d.Partial(false)
 
+	client := meta.(*gokong.KongAdminClient)
+
 	certificateRequest := createKongCertificateRequestFromResourceData(d)
 
-	_, err := meta.(*gokong.KongAdminClient).Certificates().UpdateById(d.Id(), certificateRequest)
+	// an expiring certificate that is left as it is must not stop other changes from being applied
+	if d.HasChange("certificate") || d.HasChange("private_key") {
+
+		// state only holds the hash of the private key so a new certificate is checked against the key kong has
+		if !d.HasChange("private_key") {
+			current, err := client.Certificates().GetById(d.Id())
+
+			if err != nil {
+				return fmt.Errorf("could not find kong certificate: %v", err)
+			}
+
+			if current != nil {
+				certificateRequest.Key = current.Key
+			}
+		}
+
+		if err := validateKongCertificateRequest(d, certificateRequest); err != nil {
+			return err
+		}
+	}
+
+	// the key kong already has is kept when the private key has not changed
+	if !d.HasChange("private_key") {
+		certificateRequest.Key = ""
+	}
+
+	// the snis are reconciled separately so kong does not try to create the ones that already exist
+	certificateRequest.Snis = nil
+
+	_, err := client.Certificates().UpdateById(d.Id(), certificateRequest)
 
 	if err != nil {
 		return fmt.Errorf("error updating kong certificate: %s", err)
 	}
 
+	if d.HasChange("snis") {
+		err = reconcileKongCertificateSnis(client, d.Id(), readStringSetFromResource(d, "snis"))
+
+		if err != nil {
+			return fmt.Errorf("error updating kong certificate snis: %s", err)
+		}
+	}
+
 	return resourceKongCertificateRead(d, meta)
 }
 
 func resourceKongCertificateRead(d *schema.ResourceData, meta interface{}) error {
 
-	certificate, err := meta.(*gokong.KongAdminClient).Certificates().GetById(d.Id())
+	client := meta.(*gokong.KongAdminClient)
+
+	certificate, err := client.Certificates().GetById(d.Id())
 
 	if err != nil {
 		return fmt.Errorf("could not find kong certificate: %v", err)
 	}
 
-	d.Set("certificate", certificate.Cert)
-	d.Set("private_key", certificate.Key)
+	if certificate == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("certificate", normalizePem(certificate.Cert))
+	d.Set("private_key", hashPrivateKey(certificate.Key))
+
+	// the default is not set on import so it is filled in to match the state of a created certificate
+	if _, ok := d.GetOk("min_days_remaining"); !ok {
+		d.Set("min_days_remaining", 0)
+	}
+
+	setKongCertificateInfo(d, certificate.Cert)
+
+	// snis bound by kong_sni resources are left out when the certificate does not manage its snis
+	if _, ok := d.GetOk("snis"); ok {
+		snis, err := client.Snis().ListByCertificateId(certificate.Id)
+
+		if err != nil {
+			return fmt.Errorf("could not find kong snis for certificate: %v", err)
+		}
+
+		var names []string
+		for _, sni := range snis {
+			names = append(names, sni.Name)
+		}
+
+		d.Set("snis", names)
+	}
 
 	return nil
 }
@@ -75,7 +218,7 @@ func resourceKongCertificateDelete(d *schema.ResourceData, meta interface{}) err
 
 	err := meta.(*gokong.KongAdminClient).Certificates().DeleteById(d.Id())
 
-	if err != nil {
+	if err != nil && !gokong.IsNotFound(err) {
 		return fmt.Errorf("could not delete kong certificate: %v", err)
 	}
 
@@ -86,8 +229,132 @@ func createKongCertificateRequestFromResourceData(d *schema.ResourceData) *gokon
 
 	certificateRequest := &gokong.CertificateRequest{}
 
-	certificateRequest.Cert = readStringFromResource(d, "certificate")
-	certificateRequest.Key = readStringFromResource(d, "private_key")
+	certificateRequest.Cert = normalizePem(readStringFromResource(d, "certificate"))
+	certificateRequest.Key = normalizePem(readStringFromResource(d, "private_key"))
+	certificateRequest.Snis = readStringSetFromResource(d, "snis")
 
 	return certificateRequest
 }
+
+func normalizePemStateFunc(value interface{}) string {
+	return normalizePem(value.(string))
+}
+
+func hashPrivateKeyStateFunc(value interface{}) string {
+	return hashPrivateKey(value.(string))
+}
+
+// validateCertificateNotExpired warns on plan when the configured certificate has already expired
+func validateCertificateNotExpired(value interface{}, key string) ([]string, []error) {
+
+	certificate, err := parseCertificatePem(value.(string))
+
+	if err != nil || certificate == nil {
+		return nil, nil
+	}
+
+	if certificate.NotAfter.Before(time.Now()) {
+		return []string{fmt.Sprintf("%q for %s expired on %s", key, formatCertificateName(certificate.Subject),
+			certificate.NotAfter.UTC().Format(time.RFC3339))}, nil
+	}
+
+	return nil, nil
+}
+
+// validateKongCertificateRequest checks the key pair and expiry before the certificate is sent to kong
+func validateKongCertificateRequest(d *schema.ResourceData, certificateRequest *gokong.CertificateRequest) error {
+
+	if err := validateCertificateKeyPair(certificateRequest.Cert, certificateRequest.Key); err != nil {
+		return err
+	}
+
+	certificate, err := parseCertificatePem(certificateRequest.Cert)
+
+	if err != nil {
+		return fmt.Errorf("could not parse certificate: %v", err)
+	}
+
+	return validateCertificateExpiry(certificate, readIntFromResource(d, "min_days_remaining"), time.Now())
+}
+
+// setKongCertificateInfo sets the computed attributes parsed from the certificate, they are left empty when the
+// certificate is not pem encoded
+func setKongCertificateInfo(d *schema.ResourceData, certificatePem string) {
+
+	certificate, err := parseCertificatePem(certificatePem)
+
+	if err != nil {
+		log.Printf("[WARN] could not parse kong certificate %s: %v", d.Id(), err)
+	}
+
+	if certificate == nil {
+		d.Set("not_before", "")
+		d.Set("not_after", "")
+		d.Set("subject", "")
+		d.Set("issuer", "")
+		d.Set("dns_names", nil)
+		d.Set("serial_number", "")
+		d.Set("fingerprint_sha256", "")
+		d.Set("days_remaining", 0)
+		return
+	}
+
+	d.Set("days_remaining", certificateDaysRemaining(certificate, time.Now()))
+	d.Set("not_before", certificate.NotBefore.UTC().Format(time.RFC3339))
+	d.Set("not_after", certificate.NotAfter.UTC().Format(time.RFC3339))
+	d.Set("subject", formatCertificateName(certificate.Subject))
+	d.Set("issuer", formatCertificateName(certificate.Issuer))
+	d.Set("dns_names", certificate.DNSNames)
+	d.Set("serial_number", certificate.SerialNumber.String())
+	d.Set("fingerprint_sha256", certificateFingerprintSha256(certificate))
+}
+
+// reconcileKongCertificateSnis adds the snis the certificate is missing and removes the snis that are no longer wanted,
+// snis that already point at the certificate are left untouched and snis bound to another certificate are an error
+func reconcileKongCertificateSnis(client *gokong.KongAdminClient, certificateId string, names []string) error {
+
+	snis, err := client.Snis().ListByCertificateId(certificateId)
+
+	if err != nil {
+		return err
+	}
+
+	wanted := map[string]bool{}
+	for _, name := range names {
+		wanted[name] = true
+	}
+
+	for _, sni := range snis {
+		if wanted[sni.Name] {
+			delete(wanted, sni.Name)
+			continue
+		}
+
+		if err := client.Snis().DeleteByName(sni.Name); err != nil && !gokong.IsNotFound(err) {
+			return err
+		}
+	}
+
+	for _, name := range names {
+		if !wanted[name] {
+			continue
+		}
+
+		existing, err := client.Snis().GetByName(name)
+
+		if err != nil {
+			return err
+		}
+
+		// taking the name over would leave two resources moving it back and forth on every apply
+		if existing != nil {
+			return fmt.Errorf("sni %s is bound to kong certificate %s, remove it from that certificate first", name, existing.SslCertificateId)
+		}
+
+		if _, err := client.Snis().Create(&gokong.SnisRequest{Name: name, SslCertificateId: certificateId}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
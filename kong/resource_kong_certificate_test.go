@@ -5,7 +5,9 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/kevholditch/gokong"
+	"regexp"
 	"testing"
+	"time"
 )
 
 func TestAccKongCertificate(t *testing.T) {
@@ -19,7 +21,7 @@ func TestAccKongCertificate(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckKongCertificateExists("kong_certificate.certificate"),
 					resource.TestCheckResourceAttr("kong_certificate.certificate", "certificate", "public key --- 123 ----"),
-					resource.TestCheckResourceAttr("kong_certificate.certificate", "private_key", "private key --- 456 ----"),
+					resource.TestCheckResourceAttr("kong_certificate.certificate", "private_key", hashPrivateKey("private key --- 456 ----")),
 				),
 			},
 			{
@@ -27,13 +29,148 @@ func TestAccKongCertificate(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckKongCertificateExists("kong_certificate.certificate"),
 					resource.TestCheckResourceAttr("kong_certificate.certificate", "certificate", "public key --- 789 ----"),
-					resource.TestCheckResourceAttr("kong_certificate.certificate", "private_key", "private key --- 321 ----"),
+					resource.TestCheckResourceAttr("kong_certificate.certificate", "private_key", hashPrivateKey("private key --- 321 ----")),
 				),
 			},
 		},
 	})
 }
 
+func TestAccKongCertificateWithSnis(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateCertificateWithSnisConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongCertificateExists("kong_certificate.certificate"),
+					resource.TestCheckResourceAttr("kong_certificate.certificate", "snis.#", "2"),
+					testAccCheckKongCertificateSnis("kong_certificate.certificate", "one.example.com", "two.example.com"),
+				),
+			},
+			{
+				Config: testUpdateCertificateWithSnisConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongCertificateExists("kong_certificate.certificate"),
+					resource.TestCheckResourceAttr("kong_certificate.certificate", "snis.#", "2"),
+					testAccCheckKongCertificateSnis("kong_certificate.certificate", "two.example.com", "three.example.com"),
+				),
+			},
+			{
+				Config: testCreateCertificateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongCertificateExists("kong_certificate.certificate"),
+					testAccCheckKongCertificateSnis("kong_certificate.certificate"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongCertificateInfo(t *testing.T) {
+
+	certificatePem, privateKeyPem := generateTestCertificate(t, "info.example.com", time.Now().Add(90*24*time.Hour))
+	otherCertificatePem, otherPrivateKeyPem := generateTestCertificate(t, "other.example.com", time.Now().Add(90*24*time.Hour))
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testCertificatePemConfig, certificatePem, otherPrivateKeyPem, 0),
+				ExpectError: regexp.MustCompile("the private key does not match the certificate"),
+			},
+			{
+				Config:      fmt.Sprintf(testCertificatePemConfig, certificatePem, privateKeyPem, 120),
+				ExpectError: regexp.MustCompile("at least 120 days are required"),
+			},
+			{
+				Config: fmt.Sprintf(testCertificatePemConfig, certificatePem, privateKeyPem, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongCertificateExists("kong_certificate.certificate"),
+					resource.TestCheckResourceAttr("kong_certificate.certificate", "subject", "O=Terraform Kong,CN=info.example.com"),
+					resource.TestCheckResourceAttr("kong_certificate.certificate", "issuer", "O=Terraform Kong,CN=info.example.com"),
+					resource.TestCheckResourceAttr("kong_certificate.certificate", "dns_names.#", "1"),
+					resource.TestCheckResourceAttr("kong_certificate.certificate", "dns_names.0", "info.example.com"),
+					resource.TestCheckResourceAttrSet("kong_certificate.certificate", "not_before"),
+					resource.TestCheckResourceAttrSet("kong_certificate.certificate", "not_after"),
+					resource.TestCheckResourceAttrSet("kong_certificate.certificate", "serial_number"),
+					resource.TestMatchResourceAttr("kong_certificate.certificate", "fingerprint_sha256", regexp.MustCompile("^[0-9a-f]{64}$")),
+					resource.TestCheckResourceAttr("kong_certificate.certificate", "private_key", hashPrivateKey(privateKeyPem)),
+					resource.TestMatchResourceAttr("kong_certificate.certificate", "days_remaining", regexp.MustCompile("^8[89]$")),
+				),
+			},
+			{
+				// formatting differences in the pem must not cause an update
+				Config:   fmt.Sprintf(testCertificatePemConfig, "\n\n"+certificatePem+"\n", "\n"+privateKeyPem+"\n\n", 30),
+				PlanOnly: true,
+			},
+			{
+				// a new certificate is checked against the private key kong already has
+				Config:      fmt.Sprintf(testCertificatePemConfig, otherCertificatePem, privateKeyPem, 30),
+				ExpectError: regexp.MustCompile("the private key does not match the certificate"),
+			},
+		},
+	})
+}
+
+func TestValidateCertificateNotExpired(t *testing.T) {
+
+	expiredPem, _ := generateTestCertificate(t, "expired.example.com", time.Now().Add(-time.Hour))
+	validPem, _ := generateTestCertificate(t, "valid.example.com", time.Now().Add(24*time.Hour))
+
+	if warnings, errors := validateCertificateNotExpired(expiredPem, "certificate"); len(warnings) != 1 || len(errors) != 0 {
+		t.Errorf("expected a warning for an expired certificate, got %v %v", warnings, errors)
+	}
+
+	if warnings, errors := validateCertificateNotExpired(validPem, "certificate"); len(warnings) != 0 || len(errors) != 0 {
+		t.Errorf("expected no warning for a valid certificate, got %v %v", warnings, errors)
+	}
+
+	if warnings, errors := validateCertificateNotExpired("public key --- 123 ----", "certificate"); len(warnings) != 0 || len(errors) != 0 {
+		t.Errorf("expected no warning for a value that is not pem, got %v %v", warnings, errors)
+	}
+}
+
+func TestAccKongCertificateImport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateCertificateConfig,
+			},
+			{
+				ResourceName:      "kong_certificate.certificate",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKongCertificateSniBoundToAnotherCertificate(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongCertificatesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateCertificateOwningSniConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongCertificateSnis("kong_certificate.owner", "owned.example.com"),
+				),
+			},
+			{
+				Config:      testCreateCertificateWithOwnedSniConfig,
+				ExpectError: regexp.MustCompile("sni owned.example.com is bound to kong certificate"),
+			},
+		},
+	})
+}
+
 func testAccCheckKongCertificateDestroy(state *terraform.State) error {
 
 	client := testAccProvider.Meta().(*gokong.KongAdminClient)
@@ -57,6 +194,26 @@ func testAccCheckKongCertificateDestroy(state *terraform.State) error {
 	return nil
 }
 
+// testAccCheckKongCertificatesDestroy checks every certificate in the state has been deleted
+func testAccCheckKongCertificatesDestroy(state *terraform.State) error {
+
+	client := testAccProvider.Meta().(*gokong.KongAdminClient)
+
+	for _, certificate := range getResourcesByType("kong_certificate", state) {
+		response, err := client.Certificates().GetById(certificate.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error calling get certificate by id: %v", err)
+		}
+
+		if response != nil {
+			return fmt.Errorf("certificate %s still exists, %+v", certificate.Primary.ID, response)
+		}
+	}
+
+	return nil
+}
+
 func testAccCheckKongCertificateExists(resourceKey string) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
@@ -84,6 +241,42 @@ func testAccCheckKongCertificateExists(resourceKey string) resource.TestCheckFun
 	}
 }
 
+func testAccCheckKongCertificateSnis(resourceKey string, expectedNames ...string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		snis, err := testAccProvider.Meta().(*gokong.KongAdminClient).Snis().ListByCertificateId(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if len(snis) != len(expectedNames) {
+			return fmt.Errorf("expected %d snis for certificate %v found %d", len(expectedNames), rs.Primary.ID, len(snis))
+		}
+
+		for _, name := range expectedNames {
+			found := false
+			for _, sni := range snis {
+				if sni.Name == name {
+					found = true
+				}
+			}
+
+			if !found {
+				return fmt.Errorf("sni %v not found for certificate %v", name, rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
 const testCreateCertificateConfig = `
 resource "kong_certificate" "certificate" {
 	certificate  = "public key --- 123 ----"
@@ -96,3 +289,50 @@ resource "kong_certificate" "certificate" {
 	private_key = "private key --- 321 ----"
 }
 `
+
+const testCertificatePemConfig = `
+resource "kong_certificate" "certificate" {
+	certificate        = <<EOF
+%sEOF
+	private_key        = <<EOF
+%sEOF
+	min_days_remaining = %d
+}
+`
+
+const testCreateCertificateWithSnisConfig = `
+resource "kong_certificate" "certificate" {
+	certificate  = "public key --- 123 ----"
+	private_key = "private key --- 456 ----"
+	snis        = ["one.example.com", "two.example.com"]
+}
+`
+const testUpdateCertificateWithSnisConfig = `
+resource "kong_certificate" "certificate" {
+	certificate  = "public key --- 123 ----"
+	private_key = "private key --- 456 ----"
+	snis        = ["two.example.com", "three.example.com"]
+}
+`
+
+const testCreateCertificateOwningSniConfig = `
+resource "kong_certificate" "owner" {
+	certificate  = "public key --- 123 ----"
+	private_key = "private key --- 456 ----"
+	snis        = ["owned.example.com"]
+}
+`
+const testCreateCertificateWithOwnedSniConfig = `
+resource "kong_certificate" "owner" {
+	certificate  = "public key --- 123 ----"
+	private_key = "private key --- 456 ----"
+	snis        = ["owned.example.com"]
+}
+
+resource "kong_certificate" "certificate" {
+	certificate  = "public key --- 789 ----"
+	private_key = "private key --- 321 ----"
+	snis        = ["owned.example.com"]
+	depends_on  = ["kong_certificate.owner"]
+}
+`
@@ -12,6 +12,9 @@ func resourceKongConsumer() *schema.Resource {
 		Read:   resourceKongConsumerRead,
 		Delete: resourceKongConsumerDelete,
 		Update: resourceKongConsumerUpdate,
+		Importer: &schema.ResourceImporter{
+			State: resourceKongConsumerImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"username": &schema.Schema{
@@ -66,6 +69,11 @@ func resourceKongConsumerRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("could not find kong consumer with id: %s error: %v", id, err)
 	}
 
+	if consumer == nil {
+		d.SetId("")
+		return nil
+	}
+
 	d.Set("username", consumer.Username)
 	d.Set("custom_id", consumer.CustomId)
 
@@ -76,13 +84,30 @@ func resourceKongConsumerDelete(d *schema.ResourceData, meta interface{}) error
 
 	err := meta.(*gokong.KongAdminClient).Consumers().DeleteById(d.Id())
 
-	if err != nil {
+	if err != nil && !gokong.IsNotFound(err) {
 		return fmt.Errorf("could not delete kong consumer: %v", err)
 	}
 
 	return nil
 }
 
+func resourceKongConsumerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+
+	consumer, err := meta.(*gokong.KongAdminClient).Consumers().GetByUsername(d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("could not import kong consumer: %v", err)
+	}
+
+	if consumer == nil {
+		return nil, fmt.Errorf("could not find kong consumer with id or username: %s", d.Id())
+	}
+
+	d.SetId(consumer.Id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func createKongConsumerRequestFromResourceData(d *schema.ResourceData) *gokong.ConsumerRequest {
 
 	consumerRequest := &gokong.ConsumerRequest{}
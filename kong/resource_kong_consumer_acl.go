@@ -0,0 +1,148 @@
+package kong
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kevholditch/gokong"
+)
+
+func resourceKongConsumerAcl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKongConsumerAclCreate,
+		Read:   resourceKongConsumerAclRead,
+		Delete: resourceKongConsumerAclDelete,
+		Update: resourceKongConsumerAclUpdate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"consumer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"groups": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: false,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceKongConsumerAclCreate(d *schema.ResourceData, meta interface{}) error {
+
+	if err := requireKongPlugin(meta, "acl"); err != nil {
+		return err
+	}
+
+	consumerId := readStringFromResource(d, "consumer_id")
+
+	err := reconcileKongConsumerAcls(meta.(*gokong.KongAdminClient), consumerId, readStringSetFromResource(d, "groups"))
+
+	if err != nil {
+		return fmt.Errorf("failed to create kong acls for consumer: %s error: %v", consumerId, err)
+	}
+
+	d.SetId(consumerId)
+
+	return resourceKongConsumerAclRead(d, meta)
+}
+
+func resourceKongConsumerAclUpdate(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(false)
+
+	err := reconcileKongConsumerAcls(meta.(*gokong.KongAdminClient), d.Id(), readStringSetFromResource(d, "groups"))
+
+	if err != nil {
+		return fmt.Errorf("error updating kong acls: %s", err)
+	}
+
+	return resourceKongConsumerAclRead(d, meta)
+}
+
+func resourceKongConsumerAclRead(d *schema.ResourceData, meta interface{}) error {
+
+	client := meta.(*gokong.KongAdminClient)
+
+	consumer, err := client.Consumers().GetById(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not find kong consumer: %v", err)
+	}
+
+	if consumer == nil {
+		d.SetId("")
+		return nil
+	}
+
+	acls, err := client.Credentials().ListAcls(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not find kong acls: %v", err)
+	}
+
+	var groups []string
+	for _, acl := range acls {
+		groups = append(groups, acl.Group)
+	}
+
+	d.Set("consumer_id", consumer.Id)
+	d.Set("groups", groups)
+
+	return nil
+}
+
+func resourceKongConsumerAclDelete(d *schema.ResourceData, meta interface{}) error {
+
+	err := reconcileKongConsumerAcls(meta.(*gokong.KongAdminClient), d.Id(), nil)
+
+	// the groups are removed with the consumer so there is nothing left to delete when it has gone
+	if err != nil && !gokong.IsNotFound(err) {
+		return fmt.Errorf("could not delete kong acls: %v", err)
+	}
+
+	return nil
+}
+
+// reconcileKongConsumerAcls adds the groups the consumer is missing and removes the groups that are no longer wanted,
+// groups that are already correct are left untouched
+func reconcileKongConsumerAcls(client *gokong.KongAdminClient, consumerId string, groups []string) error {
+
+	acls, err := client.Credentials().ListAcls(consumerId)
+
+	if err != nil {
+		return err
+	}
+
+	wanted := map[string]bool{}
+	for _, group := range groups {
+		wanted[group] = true
+	}
+
+	for _, acl := range acls {
+		if wanted[acl.Group] {
+			delete(wanted, acl.Group)
+			continue
+		}
+
+		if err := client.Credentials().DeleteAcl(consumerId, acl.Id); err != nil && !gokong.IsNotFound(err) {
+			return err
+		}
+	}
+
+	for _, group := range groups {
+		if !wanted[group] {
+			continue
+		}
+
+		if _, err := client.Credentials().CreateAcl(consumerId, &gokong.AclRequest{Group: group}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package kong
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/kevholditch/gokong"
+	"testing"
+)
+
+func TestAccKongConsumerAcl(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongConsumerAclDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateConsumerAclConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongConsumerAclGroups("kong_consumer_acl.consumer_acl", 2),
+					testAccCheckForChildIdCorrect("kong_consumer.consumer", "kong_consumer_acl.consumer_acl", "consumer_id"),
+					resource.TestCheckResourceAttr("kong_consumer_acl.consumer_acl", "groups.#", "2"),
+				),
+			},
+			{
+				Config: testUpdateConsumerAclConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongConsumerAclGroups("kong_consumer_acl.consumer_acl", 3),
+					testAccCheckForChildIdCorrect("kong_consumer.consumer", "kong_consumer_acl.consumer_acl", "consumer_id"),
+					resource.TestCheckResourceAttr("kong_consumer_acl.consumer_acl", "groups.#", "3"),
+				),
+			},
+			{
+				ResourceName:      "kong_consumer_acl.consumer_acl",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKongConsumerAclDestroy(state *terraform.State) error {
+
+	client := testAccProvider.Meta().(*gokong.KongAdminClient)
+
+	acls := getResourcesByType("kong_consumer_acl", state)
+
+	if len(acls) != 1 {
+		return fmt.Errorf("expecting only 1 consumer acl resource found %v", len(acls))
+	}
+
+	consumer, err := client.Consumers().GetById(acls[0].Primary.ID)
+
+	if err != nil {
+		return fmt.Errorf("error calling get consumer by id: %v", err)
+	}
+
+	if consumer == nil {
+		return nil
+	}
+
+	response, err := client.Credentials().ListAcls(acls[0].Primary.ID)
+
+	if err != nil {
+		return fmt.Errorf("error calling list acls: %v", err)
+	}
+
+	if len(response) != 0 {
+		return fmt.Errorf("acls for consumer %s still exist, %+v", acls[0].Primary.ID, response)
+	}
+
+	return nil
+}
+
+func testAccCheckKongConsumerAclGroups(resourceKey string, expectedGroups int) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		acls, err := testAccProvider.Meta().(*gokong.KongAdminClient).Credentials().ListAcls(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if len(acls) != expectedGroups {
+			return fmt.Errorf("expected consumer %v to have %d acl groups but found %d", rs.Primary.ID, expectedGroups, len(acls))
+		}
+
+		return nil
+	}
+}
+
+const testCreateConsumerAclConfig = `
+resource "kong_consumer" "consumer" {
+	username  = "AclUser"
+}
+
+resource "kong_consumer_acl" "consumer_acl" {
+	consumer_id = "${kong_consumer.consumer.id}"
+	groups      = ["admins", "developers"]
+}
+`
+const testUpdateConsumerAclConfig = `
+resource "kong_consumer" "consumer" {
+	username  = "AclUser"
+}
+
+resource "kong_consumer_acl" "consumer_acl" {
+	consumer_id = "${kong_consumer.consumer.id}"
+	groups      = ["developers", "testers", "operators"]
+}
+`
@@ -0,0 +1,111 @@
+package kong
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kevholditch/gokong"
+)
+
+func resourceKongConsumerBasicAuth() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKongConsumerBasicAuthCreate,
+		Read:   resourceKongConsumerBasicAuthRead,
+		Delete: resourceKongConsumerBasicAuthDelete,
+		Update: resourceKongConsumerBasicAuthUpdate,
+		Importer: &schema.ResourceImporter{
+			State: importConsumerCredential,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"consumer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"username": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// kong only returns a hash of the password so it is updated in place, an imported credential has no
+			// password in state and the first apply sets it to the configured one
+			"password": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  false,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceKongConsumerBasicAuthCreate(d *schema.ResourceData, meta interface{}) error {
+
+	if err := requireKongPlugin(meta, "basic-auth"); err != nil {
+		return err
+	}
+
+	consumerId := readStringFromResource(d, "consumer_id")
+	basicAuthRequest := &gokong.BasicAuthRequest{
+		Username: readStringFromResource(d, "username"),
+		Password: readStringFromResource(d, "password"),
+	}
+
+	basicAuth, err := meta.(*gokong.KongAdminClient).Credentials().CreateBasicAuth(consumerId, basicAuthRequest)
+
+	if err != nil {
+		return fmt.Errorf("failed to create kong basic auth credential for consumer: %s error: %v", consumerId, err)
+	}
+
+	d.SetId(basicAuth.Id)
+
+	return resourceKongConsumerBasicAuthRead(d, meta)
+}
+
+func resourceKongConsumerBasicAuthUpdate(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(false)
+
+	basicAuthRequest := &gokong.BasicAuthRequest{
+		Username: readStringFromResource(d, "username"),
+		Password: readStringFromResource(d, "password"),
+	}
+
+	_, err := meta.(*gokong.KongAdminClient).Credentials().UpdateBasicAuth(readStringFromResource(d, "consumer_id"), d.Id(), basicAuthRequest)
+
+	if err != nil {
+		return fmt.Errorf("error updating kong basic auth credential: %s", err)
+	}
+
+	return resourceKongConsumerBasicAuthRead(d, meta)
+}
+
+func resourceKongConsumerBasicAuthRead(d *schema.ResourceData, meta interface{}) error {
+
+	basicAuth, err := meta.(*gokong.KongAdminClient).Credentials().GetBasicAuth(readStringFromResource(d, "consumer_id"), d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not find kong basic auth credential: %v", err)
+	}
+
+	if basicAuth == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// kong only returns a hash of the password so it is left as it is in the state
+	d.Set("consumer_id", basicAuth.ConsumerId)
+	d.Set("username", basicAuth.Username)
+
+	return nil
+}
+
+func resourceKongConsumerBasicAuthDelete(d *schema.ResourceData, meta interface{}) error {
+
+	err := meta.(*gokong.KongAdminClient).Credentials().DeleteBasicAuth(readStringFromResource(d, "consumer_id"), d.Id())
+
+	if err != nil && !gokong.IsNotFound(err) {
+		return fmt.Errorf("could not delete kong basic auth credential: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package kong
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/kevholditch/gokong"
+	"testing"
+)
+
+func TestAccKongConsumerBasicAuth(t *testing.T) {
+
+	var steps []resource.TestStep
+
+	steps = []resource.TestStep{
+		{
+			Config: testCreateConsumerBasicAuthConfig,
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckKongConsumerBasicAuthExists("kong_consumer_basic_auth.credential"),
+				testAccCheckForChildIdCorrect("kong_consumer.consumer", "kong_consumer_basic_auth.credential", "consumer_id"),
+				resource.TestCheckResourceAttr("kong_consumer_basic_auth.credential", "username", "basic-user"),
+				resource.TestCheckResourceAttr("kong_consumer_basic_auth.credential", "password", "letmein"),
+			),
+		},
+		{
+			Config: testUpdateConsumerBasicAuthConfig,
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckKongConsumerBasicAuthExists("kong_consumer_basic_auth.credential"),
+				testAccCheckForChildIdCorrect("kong_consumer.consumer", "kong_consumer_basic_auth.credential", "consumer_id"),
+				resource.TestCheckResourceAttr("kong_consumer_basic_auth.credential", "username", "basic-user"),
+				resource.TestCheckResourceAttr("kong_consumer_basic_auth.credential", "password", "changed"),
+				testAccSetConsumerCredentialImportId(&steps, 2, "kong_consumer_basic_auth.credential"),
+			),
+		},
+		{
+			ResourceName:            "kong_consumer_basic_auth.credential",
+			ImportState:             true,
+			ImportStateVerify:       true,
+			ImportStateVerifyIgnore: []string{"password"},
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongConsumerBasicAuthDestroy,
+		Steps:        steps,
+	})
+}
+
+func testAccCheckKongConsumerBasicAuthDestroy(state *terraform.State) error {
+
+	client := testAccProvider.Meta().(*gokong.KongAdminClient)
+
+	credentials := getResourcesByType("kong_consumer_basic_auth", state)
+
+	if len(credentials) != 1 {
+		return fmt.Errorf("expecting only 1 basic auth resource found %v", len(credentials))
+	}
+
+	response, err := client.Credentials().GetBasicAuth(credentials[0].Primary.Attributes["consumer_id"], credentials[0].Primary.ID)
+
+	if err != nil {
+		return fmt.Errorf("error calling get basic auth by id: %v", err)
+	}
+
+	if response != nil {
+		return fmt.Errorf("basic auth %s still exists, %+v", credentials[0].Primary.ID, response)
+	}
+
+	return nil
+}
+
+func testAccCheckKongConsumerBasicAuthExists(resourceKey string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		credential, err := testAccProvider.Meta().(*gokong.KongAdminClient).Credentials().GetBasicAuth(rs.Primary.Attributes["consumer_id"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if credential == nil {
+			return fmt.Errorf("basic auth with id %v not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testCreateConsumerBasicAuthConfig = `
+resource "kong_consumer" "consumer" {
+	username  = "BasicAuthUser"
+}
+
+resource "kong_consumer_basic_auth" "credential" {
+	consumer_id = "${kong_consumer.consumer.id}"
+	username    = "basic-user"
+	password    = "letmein"
+}
+`
+const testUpdateConsumerBasicAuthConfig = `
+resource "kong_consumer" "consumer" {
+	username  = "BasicAuthUser"
+}
+
+resource "kong_consumer_basic_auth" "credential" {
+	consumer_id = "${kong_consumer.consumer.id}"
+	username    = "basic-user"
+	password    = "changed"
+}
+`
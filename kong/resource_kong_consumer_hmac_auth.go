@@ -0,0 +1,92 @@
+package kong
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kevholditch/gokong"
+)
+
+func resourceKongConsumerHmacAuth() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKongConsumerHmacAuthCreate,
+		Read:   resourceKongConsumerHmacAuthRead,
+		Delete: resourceKongConsumerHmacAuthDelete,
+		Importer: &schema.ResourceImporter{
+			State: importConsumerCredential,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"consumer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"username": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"secret": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Computed:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceKongConsumerHmacAuthCreate(d *schema.ResourceData, meta interface{}) error {
+
+	if err := requireKongPlugin(meta, "hmac-auth"); err != nil {
+		return err
+	}
+
+	consumerId := readStringFromResource(d, "consumer_id")
+	hmacAuthRequest := &gokong.HmacAuthRequest{
+		Username: readStringFromResource(d, "username"),
+		Secret:   readStringFromResource(d, "secret"),
+	}
+
+	hmacAuth, err := meta.(*gokong.KongAdminClient).Credentials().CreateHmacAuth(consumerId, hmacAuthRequest)
+
+	if err != nil {
+		return fmt.Errorf("failed to create kong hmac auth credential for consumer: %s error: %v", consumerId, err)
+	}
+
+	d.SetId(hmacAuth.Id)
+
+	return resourceKongConsumerHmacAuthRead(d, meta)
+}
+
+func resourceKongConsumerHmacAuthRead(d *schema.ResourceData, meta interface{}) error {
+
+	hmacAuth, err := meta.(*gokong.KongAdminClient).Credentials().GetHmacAuth(readStringFromResource(d, "consumer_id"), d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not find kong hmac auth credential: %v", err)
+	}
+
+	if hmacAuth == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("consumer_id", hmacAuth.ConsumerId)
+	d.Set("username", hmacAuth.Username)
+	d.Set("secret", hmacAuth.Secret)
+
+	return nil
+}
+
+func resourceKongConsumerHmacAuthDelete(d *schema.ResourceData, meta interface{}) error {
+
+	err := meta.(*gokong.KongAdminClient).Credentials().DeleteHmacAuth(readStringFromResource(d, "consumer_id"), d.Id())
+
+	if err != nil && !gokong.IsNotFound(err) {
+		return fmt.Errorf("could not delete kong hmac auth credential: %v", err)
+	}
+
+	return nil
+}
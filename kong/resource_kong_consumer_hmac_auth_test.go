@@ -0,0 +1,118 @@
+package kong
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/kevholditch/gokong"
+	"testing"
+)
+
+func TestAccKongConsumerHmacAuth(t *testing.T) {
+
+	var steps []resource.TestStep
+
+	steps = []resource.TestStep{
+		{
+			Config: testCreateConsumerHmacAuthConfig,
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckKongConsumerHmacAuthExists("kong_consumer_hmac_auth.credential"),
+				testAccCheckForChildIdCorrect("kong_consumer.consumer", "kong_consumer_hmac_auth.credential", "consumer_id"),
+				resource.TestCheckResourceAttr("kong_consumer_hmac_auth.credential", "username", "hmac-user"),
+				resource.TestCheckResourceAttr("kong_consumer_hmac_auth.credential", "secret", "hmac-secret"),
+			),
+		},
+		{
+			Config: testUpdateConsumerHmacAuthConfig,
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckKongConsumerHmacAuthExists("kong_consumer_hmac_auth.credential"),
+				testAccCheckForChildIdCorrect("kong_consumer.consumer", "kong_consumer_hmac_auth.credential", "consumer_id"),
+				resource.TestCheckResourceAttr("kong_consumer_hmac_auth.credential", "username", "hmac-user2"),
+				testAccSetConsumerCredentialImportId(&steps, 2, "kong_consumer_hmac_auth.credential"),
+			),
+		},
+		{
+			ResourceName:      "kong_consumer_hmac_auth.credential",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongConsumerHmacAuthDestroy,
+		Steps:        steps,
+	})
+}
+
+func testAccCheckKongConsumerHmacAuthDestroy(state *terraform.State) error {
+
+	client := testAccProvider.Meta().(*gokong.KongAdminClient)
+
+	credentials := getResourcesByType("kong_consumer_hmac_auth", state)
+
+	if len(credentials) != 1 {
+		return fmt.Errorf("expecting only 1 hmac auth resource found %v", len(credentials))
+	}
+
+	response, err := client.Credentials().GetHmacAuth(credentials[0].Primary.Attributes["consumer_id"], credentials[0].Primary.ID)
+
+	if err != nil {
+		return fmt.Errorf("error calling get hmac auth by id: %v", err)
+	}
+
+	if response != nil {
+		return fmt.Errorf("hmac auth %s still exists, %+v", credentials[0].Primary.ID, response)
+	}
+
+	return nil
+}
+
+func testAccCheckKongConsumerHmacAuthExists(resourceKey string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		credential, err := testAccProvider.Meta().(*gokong.KongAdminClient).Credentials().GetHmacAuth(rs.Primary.Attributes["consumer_id"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if credential == nil {
+			return fmt.Errorf("hmac auth with id %v not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testCreateConsumerHmacAuthConfig = `
+resource "kong_consumer" "consumer" {
+	username  = "HmacAuthUser"
+}
+
+resource "kong_consumer_hmac_auth" "credential" {
+	consumer_id = "${kong_consumer.consumer.id}"
+	username    = "hmac-user"
+	secret      = "hmac-secret"
+}
+`
+const testUpdateConsumerHmacAuthConfig = `
+resource "kong_consumer" "consumer" {
+	username  = "HmacAuthUser"
+}
+
+resource "kong_consumer_hmac_auth" "credential" {
+	consumer_id = "${kong_consumer.consumer.id}"
+	username    = "hmac-user2"
+}
+`
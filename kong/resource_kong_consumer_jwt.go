@@ -0,0 +1,108 @@
+package kong
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kevholditch/gokong"
+)
+
+func resourceKongConsumerJwt() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKongConsumerJwtCreate,
+		Read:   resourceKongConsumerJwtRead,
+		Delete: resourceKongConsumerJwtDelete,
+		Importer: &schema.ResourceImporter{
+			State: importConsumerCredential,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"consumer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"key": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"secret": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Computed:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"algorithm": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "HS256",
+			},
+			"rsa_public_key": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceKongConsumerJwtCreate(d *schema.ResourceData, meta interface{}) error {
+
+	if err := requireKongPlugin(meta, "jwt"); err != nil {
+		return err
+	}
+
+	consumerId := readStringFromResource(d, "consumer_id")
+	jwtRequest := &gokong.JwtRequest{
+		Key:          readStringFromResource(d, "key"),
+		Secret:       readStringFromResource(d, "secret"),
+		Algorithm:    readStringFromResource(d, "algorithm"),
+		RsaPublicKey: readStringFromResource(d, "rsa_public_key"),
+	}
+
+	jwt, err := meta.(*gokong.KongAdminClient).Credentials().CreateJwt(consumerId, jwtRequest)
+
+	if err != nil {
+		return fmt.Errorf("failed to create kong jwt credential for consumer: %s error: %v", consumerId, err)
+	}
+
+	d.SetId(jwt.Id)
+
+	return resourceKongConsumerJwtRead(d, meta)
+}
+
+func resourceKongConsumerJwtRead(d *schema.ResourceData, meta interface{}) error {
+
+	jwt, err := meta.(*gokong.KongAdminClient).Credentials().GetJwt(readStringFromResource(d, "consumer_id"), d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not find kong jwt credential: %v", err)
+	}
+
+	if jwt == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("consumer_id", jwt.ConsumerId)
+	d.Set("key", jwt.Key)
+	d.Set("secret", jwt.Secret)
+	d.Set("algorithm", jwt.Algorithm)
+	d.Set("rsa_public_key", jwt.RsaPublicKey)
+
+	return nil
+}
+
+func resourceKongConsumerJwtDelete(d *schema.ResourceData, meta interface{}) error {
+
+	err := meta.(*gokong.KongAdminClient).Credentials().DeleteJwt(readStringFromResource(d, "consumer_id"), d.Id())
+
+	if err != nil && !gokong.IsNotFound(err) {
+		return fmt.Errorf("could not delete kong jwt credential: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package kong
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/kevholditch/gokong"
+	"testing"
+)
+
+func TestAccKongConsumerJwt(t *testing.T) {
+
+	var steps []resource.TestStep
+
+	steps = []resource.TestStep{
+		{
+			Config: testCreateConsumerJwtConfig,
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckKongConsumerJwtExists("kong_consumer_jwt.credential"),
+				testAccCheckForChildIdCorrect("kong_consumer.consumer", "kong_consumer_jwt.credential", "consumer_id"),
+				resource.TestCheckResourceAttr("kong_consumer_jwt.credential", "key", "my-issuer"),
+				resource.TestCheckResourceAttr("kong_consumer_jwt.credential", "secret", "jwt-secret"),
+				resource.TestCheckResourceAttr("kong_consumer_jwt.credential", "algorithm", "HS256"),
+			),
+		},
+		{
+			Config: testUpdateConsumerJwtConfig,
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckKongConsumerJwtExists("kong_consumer_jwt.credential"),
+				testAccCheckForChildIdCorrect("kong_consumer.consumer", "kong_consumer_jwt.credential", "consumer_id"),
+				resource.TestCheckResourceAttr("kong_consumer_jwt.credential", "key", "my-other-issuer"),
+				resource.TestCheckResourceAttr("kong_consumer_jwt.credential", "algorithm", "HS512"),
+				testAccSetConsumerCredentialImportId(&steps, 2, "kong_consumer_jwt.credential"),
+			),
+		},
+		{
+			ResourceName:      "kong_consumer_jwt.credential",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongConsumerJwtDestroy,
+		Steps:        steps,
+	})
+}
+
+func testAccCheckKongConsumerJwtDestroy(state *terraform.State) error {
+
+	client := testAccProvider.Meta().(*gokong.KongAdminClient)
+
+	credentials := getResourcesByType("kong_consumer_jwt", state)
+
+	if len(credentials) != 1 {
+		return fmt.Errorf("expecting only 1 jwt resource found %v", len(credentials))
+	}
+
+	response, err := client.Credentials().GetJwt(credentials[0].Primary.Attributes["consumer_id"], credentials[0].Primary.ID)
+
+	if err != nil {
+		return fmt.Errorf("error calling get jwt by id: %v", err)
+	}
+
+	if response != nil {
+		return fmt.Errorf("jwt %s still exists, %+v", credentials[0].Primary.ID, response)
+	}
+
+	return nil
+}
+
+func testAccCheckKongConsumerJwtExists(resourceKey string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		credential, err := testAccProvider.Meta().(*gokong.KongAdminClient).Credentials().GetJwt(rs.Primary.Attributes["consumer_id"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if credential == nil {
+			return fmt.Errorf("jwt with id %v not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testCreateConsumerJwtConfig = `
+resource "kong_consumer" "consumer" {
+	username  = "JwtUser"
+}
+
+resource "kong_consumer_jwt" "credential" {
+	consumer_id = "${kong_consumer.consumer.id}"
+	key         = "my-issuer"
+	secret      = "jwt-secret"
+}
+`
+const testUpdateConsumerJwtConfig = `
+resource "kong_consumer" "consumer" {
+	username  = "JwtUser"
+}
+
+resource "kong_consumer_jwt" "credential" {
+	consumer_id = "${kong_consumer.consumer.id}"
+	key         = "my-other-issuer"
+	algorithm   = "HS512"
+}
+`
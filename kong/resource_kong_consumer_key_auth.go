@@ -0,0 +1,85 @@
+package kong
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kevholditch/gokong"
+)
+
+func resourceKongConsumerKeyAuth() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKongConsumerKeyAuthCreate,
+		Read:   resourceKongConsumerKeyAuthRead,
+		Delete: resourceKongConsumerKeyAuthDelete,
+		Importer: &schema.ResourceImporter{
+			State: importConsumerCredential,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"consumer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"key": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Computed:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceKongConsumerKeyAuthCreate(d *schema.ResourceData, meta interface{}) error {
+
+	if err := requireKongPlugin(meta, "key-auth"); err != nil {
+		return err
+	}
+
+	consumerId := readStringFromResource(d, "consumer_id")
+	keyAuthRequest := &gokong.KeyAuthRequest{
+		Key: readStringFromResource(d, "key"),
+	}
+
+	keyAuth, err := meta.(*gokong.KongAdminClient).Credentials().CreateKeyAuth(consumerId, keyAuthRequest)
+
+	if err != nil {
+		return fmt.Errorf("failed to create kong key auth credential for consumer: %s error: %v", consumerId, err)
+	}
+
+	d.SetId(keyAuth.Id)
+
+	return resourceKongConsumerKeyAuthRead(d, meta)
+}
+
+func resourceKongConsumerKeyAuthRead(d *schema.ResourceData, meta interface{}) error {
+
+	keyAuth, err := meta.(*gokong.KongAdminClient).Credentials().GetKeyAuth(readStringFromResource(d, "consumer_id"), d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not find kong key auth credential: %v", err)
+	}
+
+	if keyAuth == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("consumer_id", keyAuth.ConsumerId)
+	d.Set("key", keyAuth.Key)
+
+	return nil
+}
+
+func resourceKongConsumerKeyAuthDelete(d *schema.ResourceData, meta interface{}) error {
+
+	err := meta.(*gokong.KongAdminClient).Credentials().DeleteKeyAuth(readStringFromResource(d, "consumer_id"), d.Id())
+
+	if err != nil && !gokong.IsNotFound(err) {
+		return fmt.Errorf("could not delete kong key auth credential: %v", err)
+	}
+
+	return nil
+}
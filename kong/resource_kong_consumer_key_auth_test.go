@@ -0,0 +1,116 @@
+package kong
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/kevholditch/gokong"
+	"testing"
+)
+
+func TestAccKongConsumerKeyAuth(t *testing.T) {
+
+	var steps []resource.TestStep
+
+	steps = []resource.TestStep{
+		{
+			Config: testCreateConsumerKeyAuthConfig,
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckKongConsumerKeyAuthExists("kong_consumer_key_auth.credential"),
+				testAccCheckForChildIdCorrect("kong_consumer.consumer", "kong_consumer_key_auth.credential", "consumer_id"),
+				resource.TestCheckResourceAttr("kong_consumer_key_auth.credential", "key", "my-secret-key"),
+			),
+		},
+		{
+			Config: testUpdateConsumerKeyAuthConfig,
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckKongConsumerKeyAuthExists("kong_consumer_key_auth.credential"),
+				testAccCheckForChildIdCorrect("kong_consumer.consumer", "kong_consumer_key_auth.credential", "consumer_id"),
+				resource.TestCheckResourceAttr("kong_consumer_key_auth.credential", "key", "my-rotated-key"),
+				testAccSetConsumerCredentialImportId(&steps, 2, "kong_consumer_key_auth.credential"),
+			),
+		},
+		{
+			ResourceName:      "kong_consumer_key_auth.credential",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongConsumerKeyAuthDestroy,
+		Steps:        steps,
+	})
+}
+
+func testAccCheckKongConsumerKeyAuthDestroy(state *terraform.State) error {
+
+	client := testAccProvider.Meta().(*gokong.KongAdminClient)
+
+	credentials := getResourcesByType("kong_consumer_key_auth", state)
+
+	if len(credentials) != 1 {
+		return fmt.Errorf("expecting only 1 key auth resource found %v", len(credentials))
+	}
+
+	response, err := client.Credentials().GetKeyAuth(credentials[0].Primary.Attributes["consumer_id"], credentials[0].Primary.ID)
+
+	if err != nil {
+		return fmt.Errorf("error calling get key auth by id: %v", err)
+	}
+
+	if response != nil {
+		return fmt.Errorf("key auth %s still exists, %+v", credentials[0].Primary.ID, response)
+	}
+
+	return nil
+}
+
+func testAccCheckKongConsumerKeyAuthExists(resourceKey string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		credential, err := testAccProvider.Meta().(*gokong.KongAdminClient).Credentials().GetKeyAuth(rs.Primary.Attributes["consumer_id"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if credential == nil {
+			return fmt.Errorf("key auth with id %v not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testCreateConsumerKeyAuthConfig = `
+resource "kong_consumer" "consumer" {
+	username  = "KeyAuthUser"
+}
+
+resource "kong_consumer_key_auth" "credential" {
+	consumer_id = "${kong_consumer.consumer.id}"
+	key         = "my-secret-key"
+}
+`
+const testUpdateConsumerKeyAuthConfig = `
+resource "kong_consumer" "consumer" {
+	username  = "KeyAuthUser"
+}
+
+resource "kong_consumer_key_auth" "credential" {
+	consumer_id = "${kong_consumer.consumer.id}"
+	key         = "my-rotated-key"
+}
+`
@@ -0,0 +1,130 @@
+package kong
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kevholditch/gokong"
+)
+
+func resourceKongConsumerOauth2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKongConsumerOauth2Create,
+		Read:   resourceKongConsumerOauth2Read,
+		Delete: resourceKongConsumerOauth2Delete,
+		Update: resourceKongConsumerOauth2Update,
+		Importer: &schema.ResourceImporter{
+			State: importConsumerCredential,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"consumer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: false,
+			},
+			"client_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: false,
+			},
+			"client_secret": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Computed:  true,
+				ForceNew:  false,
+				Sensitive: true,
+			},
+			"redirect_uris": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: false,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceKongConsumerOauth2Create(d *schema.ResourceData, meta interface{}) error {
+
+	if err := requireKongPlugin(meta, "oauth2"); err != nil {
+		return err
+	}
+
+	consumerId := readStringFromResource(d, "consumer_id")
+	oauth2Request := createKongConsumerOauth2RequestFromResourceData(d)
+
+	oauth2, err := meta.(*gokong.KongAdminClient).Credentials().CreateOauth2(consumerId, oauth2Request)
+
+	if err != nil {
+		return fmt.Errorf("failed to create kong oauth2 application for consumer: %s error: %v", consumerId, err)
+	}
+
+	d.SetId(oauth2.Id)
+
+	return resourceKongConsumerOauth2Read(d, meta)
+}
+
+func resourceKongConsumerOauth2Update(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(false)
+
+	oauth2Request := createKongConsumerOauth2RequestFromResourceData(d)
+
+	_, err := meta.(*gokong.KongAdminClient).Credentials().UpdateOauth2(readStringFromResource(d, "consumer_id"), d.Id(), oauth2Request)
+
+	if err != nil {
+		return fmt.Errorf("error updating kong oauth2 application: %s", err)
+	}
+
+	return resourceKongConsumerOauth2Read(d, meta)
+}
+
+func resourceKongConsumerOauth2Read(d *schema.ResourceData, meta interface{}) error {
+
+	oauth2, err := meta.(*gokong.KongAdminClient).Credentials().GetOauth2(readStringFromResource(d, "consumer_id"), d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not find kong oauth2 application: %v", err)
+	}
+
+	if oauth2 == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("consumer_id", oauth2.ConsumerId)
+	d.Set("name", oauth2.Name)
+	d.Set("client_id", oauth2.ClientId)
+	d.Set("client_secret", oauth2.ClientSecret)
+	d.Set("redirect_uris", oauth2.RedirectUris)
+
+	return nil
+}
+
+func resourceKongConsumerOauth2Delete(d *schema.ResourceData, meta interface{}) error {
+
+	err := meta.(*gokong.KongAdminClient).Credentials().DeleteOauth2(readStringFromResource(d, "consumer_id"), d.Id())
+
+	if err != nil && !gokong.IsNotFound(err) {
+		return fmt.Errorf("could not delete kong oauth2 application: %v", err)
+	}
+
+	return nil
+}
+
+func createKongConsumerOauth2RequestFromResourceData(d *schema.ResourceData) *gokong.Oauth2Request {
+
+	oauth2Request := &gokong.Oauth2Request{}
+
+	oauth2Request.Name = readStringFromResource(d, "name")
+	oauth2Request.ClientId = readStringFromResource(d, "client_id")
+	oauth2Request.ClientSecret = readStringFromResource(d, "client_secret")
+	oauth2Request.RedirectUris = readStringArrayFromResource(d, "redirect_uris")
+
+	return oauth2Request
+}
@@ -0,0 +1,129 @@
+package kong
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/kevholditch/gokong"
+	"testing"
+)
+
+func TestAccKongConsumerOauth2(t *testing.T) {
+
+	var steps []resource.TestStep
+
+	steps = []resource.TestStep{
+		{
+			Config: testCreateConsumerOauth2Config,
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckKongConsumerOauth2Exists("kong_consumer_oauth2.credential"),
+				testAccCheckForChildIdCorrect("kong_consumer.consumer", "kong_consumer_oauth2.credential", "consumer_id"),
+				resource.TestCheckResourceAttr("kong_consumer_oauth2.credential", "name", "my-application"),
+				resource.TestCheckResourceAttr("kong_consumer_oauth2.credential", "client_id", "my-client-id"),
+				resource.TestCheckResourceAttrSet("kong_consumer_oauth2.credential", "client_secret"),
+				resource.TestCheckResourceAttr("kong_consumer_oauth2.credential", "redirect_uris.#", "1"),
+				resource.TestCheckResourceAttr("kong_consumer_oauth2.credential", "redirect_uris.0", "https://example.com/callback"),
+			),
+		},
+		{
+			Config: testUpdateConsumerOauth2Config,
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckKongConsumerOauth2Exists("kong_consumer_oauth2.credential"),
+				testAccCheckForChildIdCorrect("kong_consumer.consumer", "kong_consumer_oauth2.credential", "consumer_id"),
+				resource.TestCheckResourceAttr("kong_consumer_oauth2.credential", "name", "my-renamed-application"),
+				resource.TestCheckResourceAttr("kong_consumer_oauth2.credential", "client_id", "my-client-id"),
+				resource.TestCheckResourceAttr("kong_consumer_oauth2.credential", "client_secret", "my-client-secret"),
+				resource.TestCheckResourceAttr("kong_consumer_oauth2.credential", "redirect_uris.#", "2"),
+				resource.TestCheckResourceAttr("kong_consumer_oauth2.credential", "redirect_uris.1", "https://example.org/callback"),
+				testAccSetConsumerCredentialImportId(&steps, 2, "kong_consumer_oauth2.credential"),
+			),
+		},
+		{
+			ResourceName:      "kong_consumer_oauth2.credential",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongConsumerOauth2Destroy,
+		Steps:        steps,
+	})
+}
+
+func testAccCheckKongConsumerOauth2Destroy(state *terraform.State) error {
+
+	client := testAccProvider.Meta().(*gokong.KongAdminClient)
+
+	credentials := getResourcesByType("kong_consumer_oauth2", state)
+
+	if len(credentials) != 1 {
+		return fmt.Errorf("expecting only 1 oauth2 resource found %v", len(credentials))
+	}
+
+	response, err := client.Credentials().GetOauth2(credentials[0].Primary.Attributes["consumer_id"], credentials[0].Primary.ID)
+
+	if err != nil {
+		return fmt.Errorf("error calling get oauth2 by id: %v", err)
+	}
+
+	if response != nil {
+		return fmt.Errorf("oauth2 %s still exists, %+v", credentials[0].Primary.ID, response)
+	}
+
+	return nil
+}
+
+func testAccCheckKongConsumerOauth2Exists(resourceKey string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		credential, err := testAccProvider.Meta().(*gokong.KongAdminClient).Credentials().GetOauth2(rs.Primary.Attributes["consumer_id"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if credential == nil {
+			return fmt.Errorf("oauth2 with id %v not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testCreateConsumerOauth2Config = `
+resource "kong_consumer" "consumer" {
+	username  = "Oauth2User"
+}
+
+resource "kong_consumer_oauth2" "credential" {
+	consumer_id   = "${kong_consumer.consumer.id}"
+	name          = "my-application"
+	client_id     = "my-client-id"
+	redirect_uris = ["https://example.com/callback"]
+}
+`
+const testUpdateConsumerOauth2Config = `
+resource "kong_consumer" "consumer" {
+	username  = "Oauth2User"
+}
+
+resource "kong_consumer_oauth2" "credential" {
+	consumer_id   = "${kong_consumer.consumer.id}"
+	name          = "my-renamed-application"
+	client_id     = "my-client-id"
+	client_secret = "my-client-secret"
+	redirect_uris = ["https://example.com/callback", "https://example.org/callback"]
+}
+`
@@ -34,6 +34,29 @@ func TestAccKongConsumer(t *testing.T) {
 	})
 }
 
+func TestAccKongConsumerImport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongConsumerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateConsumerConfig,
+			},
+			{
+				ResourceName:      "kong_consumer.consumer",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "kong_consumer.consumer",
+				ImportStateId:     "User1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckKongConsumerDestroy(state *terraform.State) error {
 
 	client := testAccProvider.Meta().(*gokong.KongAdminClient)
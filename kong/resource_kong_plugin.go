@@ -1,9 +1,13 @@
 package kong
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/kevholditch/gokong"
+	"log"
+	"strconv"
+	"strings"
 )
 
 func resourceKongPlugin() *schema.Resource {
@@ -12,6 +16,9 @@ func resourceKongPlugin() *schema.Resource {
 		Read:   resourceKongPluginRead,
 		Delete: resourceKongPluginDelete,
 		Update: resourceKongPluginUpdate,
+		Importer: &schema.ResourceImporter{
+			State: resourceKongPluginImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -29,11 +36,25 @@ func resourceKongPlugin() *schema.Resource {
 				Optional: true,
 				ForceNew: false,
 			},
-			"config": &schema.Schema{
-				Type:     schema.TypeMap,
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
 				Optional: true,
-				Elem:     schema.TypeString,
-				Default:  nil,
+				ForceNew: false,
+				Default:  true,
+			},
+			"config": &schema.Schema{
+				Type:          schema.TypeMap,
+				Optional:      true,
+				Elem:          schema.TypeString,
+				Default:       nil,
+				ConflictsWith: []string{"config_json"},
+			},
+			"config_json": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"config"},
+				ValidateFunc:  validatePluginConfigJson,
+				StateFunc:     normalizePluginConfigJson,
 			},
 		},
 	}
@@ -41,6 +62,10 @@ func resourceKongPlugin() *schema.Resource {
 
 func resourceKongPluginCreate(d *schema.ResourceData, meta interface{}) error {
 
+	if err := requireKongPlugin(meta, readStringFromResource(d, "name")); err != nil {
+		return err
+	}
+
 	pluginRequest := createKongPluginRequestFromResourceData(d)
 
 	plugin, err := meta.(*gokong.KongAdminClient).Plugins().Create(pluginRequest)
@@ -51,20 +76,51 @@ func resourceKongPluginCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(plugin.Id)
 
+	if err := checkKongPluginScope(plugin, pluginRequest); err != nil {
+		return err
+	}
+
 	return resourceKongPluginRead(d, meta)
 }
 
 func resourceKongPluginUpdate(d *schema.ResourceData, meta interface{}) error {
 	d.Partial(false)
 
+	client := meta.(*gokong.KongAdminClient)
+
 	pluginRequest := createKongPluginRequestFromResourceData(d)
 
-	_, err := meta.(*gokong.KongAdminClient).Plugins().UpdateById(d.Id(), pluginRequest)
+	// a scope that is left out is not sent at all, an explicit null is needed to remove it from the plugin
+	pluginRequest.ClearApiId = d.HasChange("api_id") && pluginRequest.ApiId == ""
+	pluginRequest.ClearConsumerId = d.HasChange("consumer_id") && pluginRequest.ConsumerId == ""
 
-	if err != nil {
+	scopeChanged := d.HasChange("api_id") || d.HasChange("consumer_id")
+
+	plugin, err := client.Plugins().UpdateById(d.Id(), pluginRequest)
+
+	if err != nil && (!scopeChanged || !isKongPluginScopeError(err)) {
 		return fmt.Errorf("error updating kong plugin: %s", err)
 	}
 
+	// kong versions that cannot move a plugin to another scope reject the update or keep the old scope,
+	// the plugin is recreated with the declared scope instead
+	if scopeChanged && (err != nil || checkKongPluginScope(plugin, pluginRequest) != nil) {
+		plugin, err = recreateKongPlugin(client, d.Id(), pluginRequest)
+
+		// the new plugin is tracked even when the old one could not be deleted so it is not left behind
+		if plugin != nil {
+			d.SetId(plugin.Id)
+		}
+
+		if err != nil {
+			return fmt.Errorf("error updating kong plugin scope: %s", err)
+		}
+	}
+
+	if err := checkKongPluginScope(plugin, pluginRequest); err != nil {
+		return err
+	}
+
 	return resourceKongPluginRead(d, meta)
 }
 
@@ -76,7 +132,25 @@ func resourceKongPluginRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("could not find kong plugin: %v", err)
 	}
 
+	if plugin == nil {
+		d.SetId("")
+		return nil
+	}
+
 	d.Set("name", plugin.Name)
+	d.Set("api_id", plugin.ApiId)
+	d.Set("consumer_id", plugin.ConsumerId)
+	d.Set("enabled", plugin.Enabled)
+
+	if _, ok := d.GetOk("config_json"); ok {
+		configJson, err := readDeclaredPluginConfigJson(d, plugin.Config)
+		if err != nil {
+			return fmt.Errorf("could not read config of kong plugin: %v", err)
+		}
+		d.Set("config_json", configJson)
+	} else {
+		d.Set("config", readDeclaredPluginConfig(d, plugin.Config))
+	}
 
 	return nil
 }
@@ -85,13 +159,239 @@ func resourceKongPluginDelete(d *schema.ResourceData, meta interface{}) error {
 
 	err := meta.(*gokong.KongAdminClient).Plugins().DeleteById(d.Id())
 
-	if err != nil {
+	if err != nil && !gokong.IsNotFound(err) {
 		return fmt.Errorf("could not delete kong plugin: %v", err)
 	}
 
 	return nil
 }
 
+func resourceKongPluginImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+
+	plugin, err := meta.(*gokong.KongAdminClient).Plugins().GetById(d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("could not import kong plugin: %v", err)
+	}
+
+	if plugin == nil {
+		return nil, fmt.Errorf("could not find kong plugin with id: %s", d.Id())
+	}
+
+	d.Set("config", readImportedPluginConfig(meta.(*gokong.KongAdminClient), plugin))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// readImportedPluginConfig returns the config keys whose values differ from the defaults in the plugin schema, these are
+// the keys that were set when the plugin was created so the first plan after an import is clean
+func readImportedPluginConfig(client *gokong.KongAdminClient, plugin *gokong.Plugin) map[string]string {
+
+	stored := flattenPluginConfig("", plugin.Config)
+
+	pluginSchema, err := client.Plugins().GetSchema(plugin.Name)
+
+	if err != nil || pluginSchema == nil {
+		log.Printf("[WARN] could not read the schema of kong plugin %s, the whole config is imported: %v", plugin.Name, err)
+		return stored
+	}
+
+	defaults := flattenPluginConfig("", readPluginSchemaDefaults(pluginSchema))
+
+	for key, value := range stored {
+		if defaultValue, ok := defaults[key]; ok && defaultValue == value {
+			delete(stored, key)
+		}
+	}
+
+	return stored
+}
+
+// readPluginSchemaDefaults returns the default of every field in the schema, nested in the same way as the config
+func readPluginSchemaDefaults(pluginSchema *gokong.PluginSchema) map[string]interface{} {
+
+	result := map[string]interface{}{}
+
+	for name, field := range pluginSchema.Fields {
+		if field == nil {
+			continue
+		}
+
+		if field.Default != nil {
+			result[name] = field.Default
+		} else if field.Schema != nil {
+			result[name] = readPluginSchemaDefaults(field.Schema)
+		}
+	}
+
+	return result
+}
+
+// recreateKongPlugin creates the plugin with the new scope before the old plugin is deleted, the scopes differ so the
+// two plugins do not clash, the new plugin is returned with the error when the old plugin could not be deleted
+func recreateKongPlugin(client *gokong.KongAdminClient, id string, pluginRequest *gokong.PluginRequest) (*gokong.Plugin, error) {
+
+	pluginRequest.ClearApiId = false
+	pluginRequest.ClearConsumerId = false
+
+	plugin, err := client.Plugins().Create(pluginRequest)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.Plugins().DeleteById(id); err != nil && !gokong.IsNotFound(err) {
+		return plugin, fmt.Errorf("created kong plugin %s but could not delete kong plugin %s: %v", plugin.Id, id, err)
+	}
+
+	return plugin, nil
+}
+
+// isKongPluginScopeError reports whether kong rejected the update because of the api_id or consumer_id
+func isKongPluginScopeError(err error) bool {
+
+	kongError, ok := err.(*gokong.KongError)
+
+	if !ok || kongError.StatusCode != 400 {
+		return false
+	}
+
+	_, apiIdError := kongError.Fields["api_id"]
+	_, consumerIdError := kongError.Fields["consumer_id"]
+
+	return apiIdError || consumerIdError
+}
+
+// checkKongPluginScope returns an error when kong did not scope the plugin to the declared api and consumer, so a
+// plugin cannot silently stay scoped when it was declared global or the reverse
+func checkKongPluginScope(plugin *gokong.Plugin, pluginRequest *gokong.PluginRequest) error {
+
+	if plugin.ApiId != pluginRequest.ApiId || plugin.ConsumerId != pluginRequest.ConsumerId {
+		return fmt.Errorf("kong plugin %s is scoped to api_id: %q consumer_id: %q but api_id: %q consumer_id: %q was declared",
+			plugin.Id, plugin.ApiId, plugin.ConsumerId, pluginRequest.ApiId, pluginRequest.ConsumerId)
+	}
+
+	return nil
+}
+
+// readDeclaredPluginConfig returns the values kong holds for the config keys present in the resource,
+// keys that were not declared are left out so the defaults kong fills in do not show up as a diff
+func readDeclaredPluginConfig(d *schema.ResourceData, config map[string]interface{}) map[string]string {
+
+	stored := flattenPluginConfig("", config)
+	result := map[string]string{}
+
+	for key := range readMapFromResource(d, "config") {
+		if value, ok := stored[key]; ok {
+			result[key] = value
+		}
+	}
+
+	return result
+}
+
+// readDeclaredPluginConfigJson is the config_json equivalent of readDeclaredPluginConfig, nested objects are
+// walked so that only the declared keys at every level are returned
+func readDeclaredPluginConfigJson(d *schema.ResourceData, config map[string]interface{}) (string, error) {
+
+	var declared map[string]interface{}
+	if err := json.Unmarshal([]byte(readStringFromResource(d, "config_json")), &declared); err != nil {
+		return "", err
+	}
+
+	result, err := json.Marshal(projectPluginConfig(declared, config))
+	if err != nil {
+		return "", err
+	}
+
+	return string(result), nil
+}
+
+func projectPluginConfig(declared map[string]interface{}, stored map[string]interface{}) map[string]interface{} {
+
+	result := map[string]interface{}{}
+
+	for key, declaredValue := range declared {
+		storedValue, ok := stored[key]
+		if !ok {
+			continue
+		}
+
+		declaredMap, declaredIsMap := declaredValue.(map[string]interface{})
+		storedMap, storedIsMap := storedValue.(map[string]interface{})
+		declaredList, declaredIsList := declaredValue.([]interface{})
+		if declaredIsMap && storedIsMap {
+			result[key] = projectPluginConfig(declaredMap, storedMap)
+		} else if declaredIsList && len(declaredList) == 0 && storedIsMap && len(storedMap) == 0 {
+			// kong encodes an empty array as an empty object
+			result[key] = declaredList
+		} else {
+			result[key] = storedValue
+		}
+	}
+
+	return result
+}
+
+func validatePluginConfigJson(value interface{}, key string) ([]string, []error) {
+
+	var config map[string]interface{}
+	if err := json.Unmarshal([]byte(value.(string)), &config); err != nil {
+		return nil, []error{fmt.Errorf("%s must be a json object: %v", key, err)}
+	}
+
+	return nil, nil
+}
+
+// normalizePluginConfigJson re-encodes the json so that key order and whitespace do not cause a diff
+func normalizePluginConfigJson(value interface{}) string {
+
+	var config interface{}
+	if err := json.Unmarshal([]byte(value.(string)), &config); err != nil {
+		return value.(string)
+	}
+
+	result, _ := json.Marshal(config)
+	return string(result)
+}
+
+// flattenPluginConfig converts the nested config returned by kong into the dotted string map
+// used by the config attribute e.g. {"limits": {"sms": {"minute": 10}}} becomes {"limits.sms.minute": "10"}
+func flattenPluginConfig(prefix string, config map[string]interface{}) map[string]string {
+
+	result := map[string]string{}
+
+	for key, value := range config {
+		switch v := value.(type) {
+		case nil:
+		case map[string]interface{}:
+			for nestedKey, nestedValue := range flattenPluginConfig(prefix+key+".", v) {
+				result[nestedKey] = nestedValue
+			}
+		case []interface{}:
+			var items []string
+			for _, item := range v {
+				items = append(items, formatPluginConfigValue(item))
+			}
+			result[prefix+key] = strings.Join(items, ",")
+		default:
+			result[prefix+key] = formatPluginConfigValue(v)
+		}
+	}
+
+	return result
+}
+
+// formatPluginConfigValue formats numbers without an exponent so large values such as 1000000 match the config
+func formatPluginConfigValue(value interface{}) string {
+
+	if number, ok := value.(float64); ok {
+		return strconv.FormatFloat(number, 'f', -1, 64)
+	}
+
+	return fmt.Sprintf("%v", value)
+}
+
 func createKongPluginRequestFromResourceData(d *schema.ResourceData) *gokong.PluginRequest {
 
 	pluginRequest := &gokong.PluginRequest{}
@@ -101,5 +401,13 @@ func createKongPluginRequestFromResourceData(d *schema.ResourceData) *gokong.Plu
 	pluginRequest.ConsumerId = readStringFromResource(d, "consumer_id")
 	pluginRequest.Config = readMapFromResource(d, "config")
 
+	// enabled is always sent so a disabled plugin keeps its config and can be switched back on in place
+	enabled := readBoolFromResource(d, "enabled")
+	pluginRequest.Enabled = &enabled
+
+	if configJson := readStringFromResource(d, "config_json"); configJson != "" {
+		json.Unmarshal([]byte(configJson), &pluginRequest.Config)
+	}
+
 	return pluginRequest
 }
@@ -5,6 +5,9 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/kevholditch/gokong"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"testing"
 )
 
@@ -34,6 +37,41 @@ func TestAccKongPluginForAllConsumersAndApis(t *testing.T) {
 	})
 }
 
+func TestAccKongPluginEnabled(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongPluginDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreatePluginForAllApisAndConsumersConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongPluginExists("kong_plugin.response_rate_limiting"),
+					resource.TestCheckResourceAttr("kong_plugin.response_rate_limiting", "enabled", "true"),
+					testAccCheckKongPluginEnabled("kong_plugin.response_rate_limiting", true),
+				),
+			},
+			{
+				Config: testDisablePluginConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongPluginExists("kong_plugin.response_rate_limiting"),
+					resource.TestCheckResourceAttr("kong_plugin.response_rate_limiting", "enabled", "false"),
+					resource.TestCheckResourceAttr("kong_plugin.response_rate_limiting", "config.limits.sms.minute", "10"),
+					testAccCheckKongPluginEnabled("kong_plugin.response_rate_limiting", false),
+				),
+			},
+			{
+				Config: testCreatePluginForAllApisAndConsumersConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongPluginExists("kong_plugin.response_rate_limiting"),
+					resource.TestCheckResourceAttr("kong_plugin.response_rate_limiting", "enabled", "true"),
+					testAccCheckKongPluginEnabled("kong_plugin.response_rate_limiting", true),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKongPluginForASpecificApi(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
@@ -94,6 +132,38 @@ func TestAccKongPluginForASpecificConsumer(t *testing.T) {
 	})
 }
 
+func TestAccKongPluginScopeChange(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongPluginDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreatePluginForASpecificConsumerConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongPluginExists("kong_plugin.rate_limit"),
+					testAccCheckForChildIdCorrect("kong_consumer.plugin_consumer", "kong_plugin.rate_limit", "consumer_id"),
+				),
+			},
+			{
+				Config: testUnscopePluginForASpecificConsumerConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongPluginExists("kong_plugin.rate_limit"),
+					resource.TestCheckResourceAttr("kong_plugin.rate_limit", "consumer_id", ""),
+					testAccCheckKongPluginScope("kong_plugin.rate_limit", "", ""),
+				),
+			},
+			{
+				Config: testCreatePluginForASpecificConsumerConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongPluginExists("kong_plugin.rate_limit"),
+					testAccCheckForChildIdCorrect("kong_consumer.plugin_consumer", "kong_plugin.rate_limit", "consumer_id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKongPluginForASpecificApiAndConsumer(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
@@ -128,6 +198,161 @@ func TestAccKongPluginForASpecificApiAndConsumer(t *testing.T) {
 	})
 }
 
+func TestAccKongPluginWithConfigJson(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongPluginDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreatePluginWithConfigJsonConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongPluginExists("kong_plugin.request_transformer"),
+					resource.TestCheckResourceAttr("kong_plugin.request_transformer", "name", "request-transformer"),
+					resource.TestCheckResourceAttr("kong_plugin.request_transformer", "config_json", `{"add":{"headers":["x-one:1","x-two:2"]},"remove":{"headers":["x-secret"]}}`),
+				),
+			},
+			{
+				Config: testUpdatePluginWithConfigJsonConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongPluginExists("kong_plugin.request_transformer"),
+					resource.TestCheckResourceAttr("kong_plugin.request_transformer", "config_json", `{"add":{"headers":["x-three:3"]},"remove":{"headers":["x-secret"]}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongPluginConfigChangedOutsideTerraform(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongPluginDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreatePluginForAllApisAndConsumersConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongPluginExists("kong_plugin.response_rate_limiting"),
+					resource.TestCheckResourceAttr("kong_plugin.response_rate_limiting", "enabled", "true"),
+					resource.TestCheckResourceAttr("kong_plugin.response_rate_limiting", "config.%", "1"),
+					testAccUpdateKongPluginConfig("kong_plugin.response_rate_limiting", "limits.sms.minute", "99"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testCreatePluginForAllApisAndConsumersConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("kong_plugin.response_rate_limiting", "config.limits.sms.minute", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongPluginImport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongPluginDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreatePluginForASpecificApiAndConsumerConfig,
+			},
+			{
+				ResourceName:      "kong_plugin.rate_limit",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestFlattenPluginConfigFormatsLargeNumbers(t *testing.T) {
+
+	config := flattenPluginConfig("", map[string]interface{}{
+		"hour":    float64(1000000),
+		"ratio":   float64(0.25),
+		"methods": []interface{}{"GET", float64(2000000)},
+	})
+
+	expected := map[string]string{"hour": "1000000", "ratio": "0.25", "methods": "GET,2000000"}
+
+	if !reflect.DeepEqual(config, expected) {
+		t.Errorf("expected flattened config %v but got %v", expected, config)
+	}
+}
+
+func TestReadImportedPluginConfig(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"fields": {
+			"header_name": {"type": "string", "default": "x-kong-limit"},
+			"fault_tolerant": {"type": "boolean", "default": true},
+			"redis_port": {"type": "number", "default": 6379},
+			"limits": {"type": "table", "schema": {"flexible": true, "fields": {}}}
+		}}`)
+	}))
+	defer server.Close()
+
+	client := gokong.NewClient(&gokong.Config{HostAddress: server.URL})
+
+	config := readImportedPluginConfig(client, &gokong.Plugin{
+		Name: "response-ratelimiting",
+		Config: map[string]interface{}{
+			"header_name":    "x-kong-limit",
+			"fault_tolerant": false,
+			"redis_port":     float64(6379),
+			"limits":         map[string]interface{}{"sms": map[string]interface{}{"minute": float64(77)}},
+		},
+	})
+
+	expected := map[string]string{"fault_tolerant": "false", "limits.sms.minute": "77"}
+
+	if !reflect.DeepEqual(config, expected) {
+		t.Errorf("expected imported config %v but got %v", expected, config)
+	}
+}
+
+func TestRecreateKongPluginReturnsTheNewPluginWhenTheDeleteFails(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"message": "plugin is in use"}`)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "new-plugin-id", "name": "response-ratelimiting"}`)
+	}))
+	defer server.Close()
+
+	client := gokong.NewClient(&gokong.Config{HostAddress: server.URL})
+
+	plugin, err := recreateKongPlugin(client, "old-plugin-id", &gokong.PluginRequest{Name: "response-ratelimiting"})
+
+	if err == nil {
+		t.Error("expected an error when the old plugin could not be deleted")
+	}
+
+	if plugin == nil || plugin.Id != "new-plugin-id" {
+		t.Errorf("expected the new plugin to be returned but got %v", plugin)
+	}
+}
+
+func TestIsKongPluginScopeError(t *testing.T) {
+
+	scopeError := &gokong.KongError{StatusCode: 400, Fields: map[string]string{"consumer_id": "unknown field"}}
+	configError := &gokong.KongError{StatusCode: 400, Fields: map[string]string{"config.minute": "invalid value"}}
+	serverError := &gokong.KongError{StatusCode: 500, Fields: map[string]string{"api_id": "error"}}
+
+	if !isKongPluginScopeError(scopeError) {
+		t.Error("expected a 400 for consumer_id to be a scope error")
+	}
+
+	if isKongPluginScopeError(configError) || isKongPluginScopeError(serverError) || isKongPluginScopeError(fmt.Errorf("timeout")) {
+		t.Error("expected only a 400 for api_id or consumer_id to be a scope error")
+	}
+}
+
 func testAccCheckKongPluginDestroy(state *terraform.State) error {
 
 	client := testAccProvider.Meta().(*gokong.KongAdminClient)
@@ -213,6 +438,89 @@ func testAccCheckKongPluginExists(resourceKey string) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckKongPluginEnabled(resourceKey string, expected bool) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		plugin, err := testAccProvider.Meta().(*gokong.KongAdminClient).Plugins().GetById(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if plugin == nil {
+			return fmt.Errorf("plugin with id %v not found", rs.Primary.ID)
+		}
+
+		if plugin.Enabled != expected {
+			return fmt.Errorf("expected plugin %v to have enabled %v but was %v", rs.Primary.ID, expected, plugin.Enabled)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKongPluginScope(resourceKey string, expectedApiId string, expectedConsumerId string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		plugin, err := testAccProvider.Meta().(*gokong.KongAdminClient).Plugins().GetById(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if plugin == nil {
+			return fmt.Errorf("plugin with id %v not found", rs.Primary.ID)
+		}
+
+		if plugin.ApiId != expectedApiId || plugin.ConsumerId != expectedConsumerId {
+			return fmt.Errorf("expected plugin %v to be scoped to api %q and consumer %q but was %q and %q",
+				rs.Primary.ID, expectedApiId, expectedConsumerId, plugin.ApiId, plugin.ConsumerId)
+		}
+
+		return nil
+	}
+}
+
+func testAccUpdateKongPluginConfig(resourceKey string, key string, value string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		_, err := testAccProvider.Meta().(*gokong.KongAdminClient).Plugins().UpdateById(rs.Primary.ID, &gokong.PluginRequest{
+			Name:   rs.Primary.Attributes["name"],
+			Config: map[string]interface{}{key: value},
+		})
+
+		return err
+	}
+}
+
+const testDisablePluginConfig = `
+resource "kong_plugin" "response_rate_limiting" {
+	name    = "response-ratelimiting"
+	enabled = false
+	config = {
+		limits.sms.minute = 10
+	}
+}
+`
+
 const testCreatePluginForAllApisAndConsumersConfig = `
 resource "kong_plugin" "response_rate_limiting" {
 	name  = "response-ratelimiting"
@@ -296,6 +604,20 @@ resource "kong_plugin" "rate_limit" {
 }
 `
 
+const testUnscopePluginForASpecificConsumerConfig = `
+resource "kong_consumer" "plugin_consumer" {
+	username  = "PluginUser"
+	custom_id = "567"
+}
+
+resource "kong_plugin" "rate_limit" {
+	name        = "response-ratelimiting"
+	config 		= {
+		limits.sms.minute = 20
+	}
+}
+`
+
 const testUpdatePluginForASpecificConsumerConfig = `
 resource "kong_consumer" "plugin_consumer" {
 	username  = "PluginUser"
@@ -374,3 +696,33 @@ resource "kong_plugin" "rate_limit" {
 	}
 }
 `
+const testCreatePluginWithConfigJsonConfig = `
+resource "kong_plugin" "request_transformer" {
+	name        = "request-transformer"
+	config_json = <<EOT
+	{
+		"remove": {
+			"headers": [ "x-secret" ]
+		},
+		"add": {
+			"headers": [ "x-one:1", "x-two:2" ]
+		}
+	}
+EOT
+}
+`
+const testUpdatePluginWithConfigJsonConfig = `
+resource "kong_plugin" "request_transformer" {
+	name        = "request-transformer"
+	config_json = <<EOT
+	{
+		"remove": {
+			"headers": [ "x-secret" ]
+		},
+		"add": {
+			"headers": [ "x-three:3" ]
+		}
+	}
+EOT
+}
+`
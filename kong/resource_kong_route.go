@@ -0,0 +1,158 @@
+package kong
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kevholditch/gokong"
+)
+
+func resourceKongRoute() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKongRouteCreate,
+		Read:   resourceKongRouteRead,
+		Delete: resourceKongRouteDelete,
+		Update: resourceKongRouteUpdate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"protocols": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: false,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"methods": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: false,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"hosts": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: false,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"paths": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: false,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"strip_path": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: false,
+				Default:  true,
+			},
+			"preserve_host": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: false,
+				Default:  false,
+			},
+			"regex_priority": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: false,
+				Default:  0,
+			},
+			"service_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: false,
+			},
+		},
+	}
+}
+
+func resourceKongRouteCreate(d *schema.ResourceData, meta interface{}) error {
+
+	if err := requireKongVersion(meta, "kong_route", kongServicesVersion); err != nil {
+		return err
+	}
+
+	routeRequest := createKongRouteRequestFromResourceData(d)
+
+	route, err := meta.(*gokong.KongAdminClient).Routes().Create(routeRequest)
+
+	if err != nil {
+		return fmt.Errorf("failed to create kong route: %v error: %v", routeRequest, err)
+	}
+
+	d.SetId(route.Id)
+
+	return resourceKongRouteRead(d, meta)
+}
+
+func resourceKongRouteUpdate(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(false)
+
+	routeRequest := createKongRouteRequestFromResourceData(d)
+
+	_, err := meta.(*gokong.KongAdminClient).Routes().UpdateById(d.Id(), routeRequest)
+
+	if err != nil {
+		return fmt.Errorf("error updating kong route: %s", err)
+	}
+
+	return resourceKongRouteRead(d, meta)
+}
+
+func resourceKongRouteRead(d *schema.ResourceData, meta interface{}) error {
+
+	route, err := meta.(*gokong.KongAdminClient).Routes().GetById(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not find kong route: %v", err)
+	}
+
+	if route == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("protocols", route.Protocols)
+	d.Set("methods", route.Methods)
+	d.Set("hosts", route.Hosts)
+	d.Set("paths", route.Paths)
+	d.Set("strip_path", route.StripPath)
+	d.Set("preserve_host", route.PreserveHost)
+	d.Set("regex_priority", route.RegexPriority)
+
+	if route.Service != nil {
+		d.Set("service_id", route.Service.Id)
+	}
+
+	return nil
+}
+
+func resourceKongRouteDelete(d *schema.ResourceData, meta interface{}) error {
+
+	err := meta.(*gokong.KongAdminClient).Routes().DeleteById(d.Id())
+
+	if err != nil && !gokong.IsNotFound(err) {
+		return fmt.Errorf("could not delete kong route: %v", err)
+	}
+
+	return nil
+}
+
+func createKongRouteRequestFromResourceData(d *schema.ResourceData) *gokong.RouteRequest {
+
+	routeRequest := &gokong.RouteRequest{}
+
+	routeRequest.Protocols = readStringArrayFromResource(d, "protocols")
+	routeRequest.Methods = readStringArrayFromResource(d, "methods")
+	routeRequest.Hosts = readStringArrayFromResource(d, "hosts")
+	routeRequest.Paths = readStringArrayFromResource(d, "paths")
+	routeRequest.StripPath = readBoolFromResource(d, "strip_path")
+	routeRequest.PreserveHost = readBoolFromResource(d, "preserve_host")
+	routeRequest.RegexPriority = readIntFromResource(d, "regex_priority")
+	routeRequest.Service = &gokong.RouteServiceObject{Id: readStringFromResource(d, "service_id")}
+
+	return routeRequest
+}
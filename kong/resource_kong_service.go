@@ -0,0 +1,183 @@
+package kong
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kevholditch/gokong"
+)
+
+func resourceKongService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKongServiceCreate,
+		Read:   resourceKongServiceRead,
+		Delete: resourceKongServiceDelete,
+		Update: resourceKongServiceUpdate,
+		Importer: &schema.ResourceImporter{
+			State: resourceKongServiceImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"protocol": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+				Default:  "http",
+			},
+			"host": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: false,
+			},
+			"port": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: false,
+				Default:  80,
+			},
+			"path": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"retries": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: false,
+				Default:  5,
+			},
+			"connect_timeout": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: false,
+				Default:  60000,
+			},
+			"write_timeout": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: false,
+				Default:  60000,
+			},
+			"read_timeout": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: false,
+				Default:  60000,
+			},
+		},
+	}
+}
+
+func resourceKongServiceCreate(d *schema.ResourceData, meta interface{}) error {
+
+	if err := requireKongVersion(meta, "kong_service", kongServicesVersion); err != nil {
+		return err
+	}
+
+	serviceRequest := createKongServiceRequestFromResourceData(d)
+
+	service, err := meta.(*gokong.KongAdminClient).Services().Create(serviceRequest)
+
+	if err != nil {
+		return fmt.Errorf("failed to create kong service: %v error: %v", serviceRequest.Host, err)
+	}
+
+	d.SetId(service.Id)
+
+	return resourceKongServiceRead(d, meta)
+}
+
+func resourceKongServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(false)
+
+	serviceRequest := createKongServiceRequestFromResourceData(d)
+
+	_, err := meta.(*gokong.KongAdminClient).Services().UpdateById(d.Id(), serviceRequest)
+
+	if err != nil {
+		return fmt.Errorf("error updating kong service: %s", err)
+	}
+
+	return resourceKongServiceRead(d, meta)
+}
+
+func resourceKongServiceRead(d *schema.ResourceData, meta interface{}) error {
+
+	service, err := meta.(*gokong.KongAdminClient).Services().GetById(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not find kong service: %v", err)
+	}
+
+	if service == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", service.Name)
+	d.Set("protocol", service.Protocol)
+	d.Set("host", service.Host)
+	d.Set("port", service.Port)
+	d.Set("path", service.Path)
+	d.Set("retries", service.Retries)
+	d.Set("connect_timeout", service.ConnectTimeout)
+	d.Set("write_timeout", service.WriteTimeout)
+	d.Set("read_timeout", service.ReadTimeout)
+
+	return nil
+}
+
+func resourceKongServiceDelete(d *schema.ResourceData, meta interface{}) error {
+
+	err := meta.(*gokong.KongAdminClient).Services().DeleteById(d.Id())
+
+	if err != nil && !gokong.IsNotFound(err) {
+		return fmt.Errorf("could not delete kong service: %v", err)
+	}
+
+	return nil
+}
+
+func resourceKongServiceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+
+	service, err := meta.(*gokong.KongAdminClient).Services().GetByName(d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("could not import kong service: %v", err)
+	}
+
+	if service == nil {
+		return nil, fmt.Errorf("could not find kong service with id or name: %s", d.Id())
+	}
+
+	d.SetId(service.Id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func createKongServiceRequestFromResourceData(d *schema.ResourceData) *gokong.ServiceRequest {
+
+	serviceRequest := &gokong.ServiceRequest{}
+
+	// name and path are left nil when they are not set so an update removes them from the service
+	if name := readStringFromResource(d, "name"); name != "" {
+		serviceRequest.Name = &name
+	}
+	serviceRequest.Protocol = readStringFromResource(d, "protocol")
+	serviceRequest.Host = readStringFromResource(d, "host")
+	serviceRequest.Port = readIntFromResource(d, "port")
+	if path := readStringFromResource(d, "path"); path != "" {
+		serviceRequest.Path = &path
+	}
+	retries := readIntFromResource(d, "retries")
+	serviceRequest.Retries = &retries
+	serviceRequest.ConnectTimeout = readIntFromResource(d, "connect_timeout")
+	serviceRequest.WriteTimeout = readIntFromResource(d, "write_timeout")
+	serviceRequest.ReadTimeout = readIntFromResource(d, "read_timeout")
+
+	return serviceRequest
+}
@@ -11,6 +11,10 @@ func resourceKongSni() *schema.Resource {
 		Create: resourceKongSniCreate,
 		Read:   resourceKongSniRead,
 		Delete: resourceKongSniDelete,
+		Update: resourceKongSniUpdate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -21,7 +25,7 @@ func resourceKongSni() *schema.Resource {
 			"certificate_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
+				ForceNew: false,
 			},
 		},
 	}
@@ -42,6 +46,21 @@ func resourceKongSniCreate(d *schema.ResourceData, meta interface{}) error {
 	return resourceKongSniRead(d, meta)
 }
 
+// the certificate is swapped in place so the sni keeps serving tls while it is rotated
+func resourceKongSniUpdate(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(false)
+
+	sniRequest := createKongSniRequestFromResourceData(d)
+
+	_, err := meta.(*gokong.KongAdminClient).Snis().UpdateByName(d.Id(), sniRequest)
+
+	if err != nil {
+		return fmt.Errorf("error updating kong sni: %s", err)
+	}
+
+	return resourceKongSniRead(d, meta)
+}
+
 func resourceKongSniRead(d *schema.ResourceData, meta interface{}) error {
 
 	sni, err := meta.(*gokong.KongAdminClient).Snis().GetByName(d.Id())
@@ -50,6 +69,11 @@ func resourceKongSniRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("could not find kong sni: %v", err)
 	}
 
+	if sni == nil {
+		d.SetId("")
+		return nil
+	}
+
 	d.Set("name", sni.Name)
 	d.Set("certificate_id", sni.SslCertificateId)
 
@@ -60,7 +84,7 @@ func resourceKongSniDelete(d *schema.ResourceData, meta interface{}) error {
 
 	err := meta.(*gokong.KongAdminClient).Snis().DeleteByName(d.Id())
 
-	if err != nil {
+	if err != nil && !gokong.IsNotFound(err) {
 		return fmt.Errorf("could not delete kong sni: %v", err)
 	}
 
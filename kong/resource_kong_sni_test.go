@@ -18,7 +18,7 @@ func TestAccKongSni(t *testing.T) {
 				Config: testCreateSniConfig,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckKongSniExists("kong_sni.sni"),
-					//testAccCheckForChildIdCorrect("kong_certificate.certificate1", "kong_sni.sni", "certificate_id"),
+					testAccCheckForChildIdCorrect("kong_certificate.certificate1", "kong_sni.sni", "certificate_id"),
 					resource.TestCheckResourceAttr("kong_sni.sni", "name", "www.example.com"),
 				),
 			},
@@ -26,7 +26,7 @@ func TestAccKongSni(t *testing.T) {
 				Config: testUpdateSniConfig,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckKongSniExists("kong_sni.sni"),
-					//testAccCheckForChildIdCorrect("kong_certificate.certificate2", "kong_sni.sni", "certificate_id"),
+					testAccCheckForChildIdCorrect("kong_certificate.certificate2", "kong_sni.sni", "certificate_id"),
 					resource.TestCheckResourceAttr("kong_sni.sni", "name", "www.example.com"),
 				),
 			},
@@ -34,6 +34,23 @@ func TestAccKongSni(t *testing.T) {
 	})
 }
 
+func TestAccKongSniImport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongSniDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateSniConfig,
+			},
+			{
+				ResourceName:      "kong_sni.sni",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckKongSniDestroy(state *terraform.State) error {
 
 	client := testAccProvider.Meta().(*gokong.KongAdminClient)
@@ -0,0 +1,185 @@
+package kong
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kevholditch/gokong"
+	"net"
+	"strings"
+)
+
+// kongTargetDefaultPort is the port kong adds to a target that is created without one
+const kongTargetDefaultPort = "8000"
+
+func resourceKongTarget() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKongTargetCreate,
+		Read:   resourceKongTargetRead,
+		Delete: resourceKongTargetDelete,
+		Update: resourceKongTargetUpdate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"target": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressKongTargetDefaultPortDiff,
+			},
+			"weight": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: false,
+				Default:  100,
+				ValidateFunc: func(value interface{}, key string) ([]string, []error) {
+					if weight := value.(int); weight < 1 || weight > 1000 {
+						return nil, []error{fmt.Errorf("%s must be between 1 and 1000, remove the target to stop sending it traffic", key)}
+					}
+					return nil, nil
+				},
+			},
+			"upstream_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceKongTargetCreate(d *schema.ResourceData, meta interface{}) error {
+
+	upstreamId := readStringFromResource(d, "upstream_id")
+	targetRequest := createKongTargetRequestFromResourceData(d)
+
+	target, err := meta.(*gokong.KongAdminClient).Targets().CreateFromUpstreamId(upstreamId, targetRequest)
+
+	if err != nil {
+		return fmt.Errorf("failed to create kong target: %v error: %v", targetRequest, err)
+	}
+
+	// the id uses the target kong created as kong adds the default port when none is given
+	d.SetId(fmt.Sprintf("%s/%s", upstreamId, target.Target))
+
+	return resourceKongTargetRead(d, meta)
+}
+
+// targets are append only in kong, changing the weight adds a new entry for the same target
+func resourceKongTargetUpdate(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(false)
+
+	upstreamId := readStringFromResource(d, "upstream_id")
+	targetRequest := createKongTargetRequestFromResourceData(d)
+
+	_, err := meta.(*gokong.KongAdminClient).Targets().CreateFromUpstreamId(upstreamId, targetRequest)
+
+	if err != nil {
+		return fmt.Errorf("error updating kong target: %s", err)
+	}
+
+	return resourceKongTargetRead(d, meta)
+}
+
+func resourceKongTargetRead(d *schema.ResourceData, meta interface{}) error {
+
+	upstreamId, targetName, err := splitKongTargetId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	targets, err := meta.(*gokong.KongAdminClient).Targets().GetTargetsFromUpstreamId(upstreamId)
+
+	if err != nil {
+		return fmt.Errorf("could not find kong targets for upstream %s: %v", upstreamId, err)
+	}
+
+	target := findLatestKongTarget(targets, targetName)
+
+	if target == nil || target.Weight == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("target", target.Target)
+	d.Set("weight", target.Weight)
+	d.Set("upstream_id", upstreamId)
+
+	return nil
+}
+
+func resourceKongTargetDelete(d *schema.ResourceData, meta interface{}) error {
+
+	upstreamId, targetName, err := splitKongTargetId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*gokong.KongAdminClient).Targets()
+
+	err = client.DeleteFromUpstreamByHostPort(upstreamId, targetName)
+
+	if kongError, ok := err.(*gokong.KongError); ok && (kongError.StatusCode == 404 || kongError.StatusCode == 405) {
+		// kong versions without the delete endpoint remove a target when it is added again with a weight of 0,
+		// when the upstream itself is gone the target has already been removed with it
+		_, createErr := client.CreateFromUpstreamId(upstreamId, &gokong.TargetRequest{Target: targetName, Weight: 0})
+		if createErr != nil && !gokong.IsNotFound(createErr) {
+			return fmt.Errorf("could not delete kong target: %v error: %v", err, createErr)
+		}
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("could not delete kong target: %v", err)
+	}
+
+	return nil
+}
+
+// findLatestKongTarget returns the most recent entry for the target, older kong versions return the whole history
+func findLatestKongTarget(targets []*gokong.Target, targetName string) *gokong.Target {
+
+	var latest *gokong.Target
+
+	for _, target := range targets {
+		if target.Target == targetName && (latest == nil || target.CreatedAt > latest.CreatedAt) {
+			latest = target
+		}
+	}
+
+	return latest
+}
+
+func splitKongTargetId(id string) (string, string, error) {
+
+	parts := strings.SplitN(id, "/", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("kong target id %s should be in the form upstream_id/target", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func createKongTargetRequestFromResourceData(d *schema.ResourceData) *gokong.TargetRequest {
+
+	targetRequest := &gokong.TargetRequest{}
+
+	targetRequest.Target = readStringFromResource(d, "target")
+	targetRequest.Weight = readIntFromResource(d, "weight")
+
+	return targetRequest
+}
+
+// suppressKongTargetDefaultPortDiff ignores the default port kong adds to a target that is configured without a port
+func suppressKongTargetDefaultPortDiff(k, old, new string, d *schema.ResourceData) bool {
+
+	if _, _, err := net.SplitHostPort(new); err == nil {
+		return false
+	}
+
+	return old == net.JoinHostPort(strings.Trim(new, "[]"), kongTargetDefaultPort)
+}
@@ -0,0 +1,179 @@
+package kong
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/kevholditch/gokong"
+	"testing"
+)
+
+func TestAccKongTarget(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateTargetConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongTargetExists("kong_target.target"),
+					testAccCheckForChildIdCorrect("kong_upstream.upstream", "kong_target.target", "upstream_id"),
+					resource.TestCheckResourceAttr("kong_target.target", "target", "mytarget:4000"),
+					resource.TestCheckResourceAttr("kong_target.target", "weight", "100"),
+				),
+			},
+			{
+				Config: testUpdateTargetConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongTargetExists("kong_target.target"),
+					testAccCheckForChildIdCorrect("kong_upstream.upstream", "kong_target.target", "upstream_id"),
+					resource.TestCheckResourceAttr("kong_target.target", "target", "mytarget:4000"),
+					resource.TestCheckResourceAttr("kong_target.target", "weight", "200"),
+				),
+			},
+			{
+				ResourceName:      "kong_target.target",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKongTargetWithoutPort(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateTargetWithoutPortConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongTargetExists("kong_target.target"),
+					resource.TestCheckResourceAttr("kong_target.target", "target", "mytarget:8000"),
+					resource.TestCheckResourceAttr("kong_target.target", "weight", "100"),
+				),
+			},
+		},
+	})
+}
+
+func TestSuppressKongTargetDefaultPortDiff(t *testing.T) {
+
+	cases := []struct {
+		old      string
+		new      string
+		suppress bool
+	}{
+		{"mytarget:8000", "mytarget", true},
+		{"[::1]:8000", "::1", true},
+		{"mytarget:4000", "mytarget", false},
+		{"mytarget:8000", "mytarget:4000", false},
+		{"mytarget:8000", "othertarget", false},
+	}
+
+	for _, c := range cases {
+		if suppress := suppressKongTargetDefaultPortDiff("target", c.old, c.new, nil); suppress != c.suppress {
+			t.Errorf("expected suppress %v for %s => %s but got %v", c.suppress, c.old, c.new, suppress)
+		}
+	}
+}
+
+func testAccCheckKongTargetDestroy(state *terraform.State) error {
+
+	client := testAccProvider.Meta().(*gokong.KongAdminClient)
+
+	targets := getResourcesByType("kong_target", state)
+
+	if len(targets) != 1 {
+		return fmt.Errorf("expecting only 1 target resource found %v", len(targets))
+	}
+
+	upstreamId, targetName, err := splitKongTargetId(targets[0].Primary.ID)
+
+	if err != nil {
+		return err
+	}
+
+	response, err := client.Targets().GetTargetsFromUpstreamId(upstreamId)
+
+	if err != nil {
+		return fmt.Errorf("error calling get targets by upstream id: %v", err)
+	}
+
+	if target := findLatestKongTarget(response, targetName); target != nil && target.Weight != 0 {
+		return fmt.Errorf("target %s still exists, %+v", targets[0].Primary.ID, target)
+	}
+
+	return nil
+}
+
+func testAccCheckKongTargetExists(resourceKey string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		upstreamId, targetName, err := splitKongTargetId(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		targets, err := testAccProvider.Meta().(*gokong.KongAdminClient).Targets().GetTargetsFromUpstreamId(upstreamId)
+
+		if err != nil {
+			return err
+		}
+
+		if target := findLatestKongTarget(targets, targetName); target == nil || target.Weight == 0 {
+			return fmt.Errorf("target with id %v not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testCreateTargetConfig = `
+resource "kong_upstream" "upstream" {
+	name  		= "MyUpstream"
+	slots 		= 10
+}
+
+resource "kong_target" "target" {
+	target  		= "mytarget:4000"
+	weight	  		= 100
+	upstream_id 	= "${kong_upstream.upstream.id}"
+}
+`
+const testUpdateTargetConfig = `
+resource "kong_upstream" "upstream" {
+	name  		= "MyUpstream"
+	slots 		= 10
+}
+
+resource "kong_target" "target" {
+	target  		= "mytarget:4000"
+	weight	  		= 200
+	upstream_id 	= "${kong_upstream.upstream.id}"
+}
+`
+const testCreateTargetWithoutPortConfig = `
+resource "kong_upstream" "upstream" {
+	name  		= "MyUpstream"
+	slots 		= 10
+}
+
+resource "kong_target" "target" {
+	target  		= "mytarget"
+	upstream_id 	= "${kong_upstream.upstream.id}"
+}
+`
@@ -11,24 +11,151 @@ func resourceKongUpstream() *schema.Resource {
 		Create: resourceKongUpstreamCreate,
 		Read:   resourceKongUpstreamRead,
 		Delete: resourceKongUpstreamDelete,
+		Update: resourceKongUpstreamUpdate,
+		Importer: &schema.ResourceImporter{
+			State: resourceKongUpstreamImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
+				ForceNew: false,
 			},
 			"slots": &schema.Schema{
 				Type:     schema.TypeInt,
 				Required: true,
-				ForceNew: true,
+				ForceNew: false,
 			},
 			"order_list": &schema.Schema{
 				Type:     schema.TypeList,
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeInt},
-				Default:  nil,
-				ForceNew: true,
+				Computed: true,
+				ForceNew: false,
+			},
+			"hash_on": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: false,
+			},
+			"hash_fallback": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: false,
+			},
+			"hash_on_header": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: false,
+			},
+			"hash_fallback_header": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: false,
+			},
+			"healthchecks": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"active": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"http_path": &schema.Schema{
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "/",
+									},
+									"timeout": &schema.Schema{
+										Type:     schema.TypeInt,
+										Optional: true,
+										Default:  1,
+									},
+									"concurrency": &schema.Schema{
+										Type:     schema.TypeInt,
+										Optional: true,
+										Default:  10,
+									},
+									"healthy": &schema.Schema{
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"interval":      upstreamHealthCheckCounterSchema(),
+												"http_statuses": upstreamHealthCheckStatusesSchema(),
+												"successes":     upstreamHealthCheckCounterSchema(),
+											},
+										},
+									},
+									"unhealthy": &schema.Schema{
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"interval":      upstreamHealthCheckCounterSchema(),
+												"http_statuses": upstreamHealthCheckStatusesSchema(),
+												"tcp_failures":  upstreamHealthCheckCounterSchema(),
+												"timeouts":      upstreamHealthCheckCounterSchema(),
+												"http_failures": upstreamHealthCheckCounterSchema(),
+											},
+										},
+									},
+								},
+							},
+						},
+						"passive": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"healthy": &schema.Schema{
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"http_statuses": upstreamHealthCheckStatusesSchema(),
+												"successes":     upstreamHealthCheckCounterSchema(),
+											},
+										},
+									},
+									"unhealthy": &schema.Schema{
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"http_statuses": upstreamHealthCheckStatusesSchema(),
+												"tcp_failures":  upstreamHealthCheckCounterSchema(),
+												"timeouts":      upstreamHealthCheckCounterSchema(),
+												"http_failures": upstreamHealthCheckCounterSchema(),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 		},
 	}
@@ -36,6 +163,10 @@ func resourceKongUpstream() *schema.Resource {
 
 func resourceKongUpstreamCreate(d *schema.ResourceData, meta interface{}) error {
 
+	if err := checkKongUpstreamVersion(d, meta); err != nil {
+		return err
+	}
+
 	upstreamRequest := createKongUpstreamRequestFromResourceData(d)
 
 	upstream, err := meta.(*gokong.KongAdminClient).Upstreams().Create(upstreamRequest)
@@ -49,6 +180,42 @@ func resourceKongUpstreamCreate(d *schema.ResourceData, meta interface{}) error
 	return resourceKongUpstreamRead(d, meta)
 }
 
+func resourceKongUpstreamUpdate(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(false)
+
+	if err := checkKongUpstreamVersion(d, meta); err != nil {
+		return err
+	}
+
+	upstreamRequest := createKongUpstreamRequestFromResourceData(d)
+
+	// order_list is computed from the slots so the stored list is only sent when the configuration changes it,
+	// otherwise kong generates a list that matches the new slots
+	if !d.HasChange("order_list") {
+		upstreamRequest.OrderList = nil
+	}
+
+	_, err := meta.(*gokong.KongAdminClient).Upstreams().UpdateById(d.Id(), upstreamRequest)
+
+	if err != nil {
+		return fmt.Errorf("error updating kong upstream: %s", err)
+	}
+
+	return resourceKongUpstreamRead(d, meta)
+}
+
+// checkKongUpstreamVersion rejects the hashing and health check settings on kong versions that do not support them
+func checkKongUpstreamVersion(d *schema.ResourceData, meta interface{}) error {
+
+	for _, key := range []string{"hash_on", "hash_fallback", "hash_on_header", "hash_fallback_header", "healthchecks"} {
+		if _, ok := d.GetOk(key); ok {
+			return requireKongVersion(meta, key, kongHealthChecksVersion)
+		}
+	}
+
+	return nil
+}
+
 func resourceKongUpstreamRead(d *schema.ResourceData, meta interface{}) error {
 
 	upstream, err := meta.(*gokong.KongAdminClient).Upstreams().GetById(d.Id())
@@ -57,8 +224,19 @@ func resourceKongUpstreamRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("could not find kong upstream: %v", err)
 	}
 
+	if upstream == nil {
+		d.SetId("")
+		return nil
+	}
+
 	d.Set("name", upstream.Name)
 	d.Set("slots", upstream.Slots)
+	d.Set("order_list", upstream.OrderList)
+	d.Set("hash_on", upstream.HashOn)
+	d.Set("hash_fallback", upstream.HashFallback)
+	d.Set("hash_on_header", upstream.HashOnHeader)
+	d.Set("hash_fallback_header", upstream.HashFallbackHeader)
+	d.Set("healthchecks", flattenUpstreamHealthChecks(upstream.HealthChecks))
 
 	return nil
 }
@@ -67,13 +245,30 @@ func resourceKongUpstreamDelete(d *schema.ResourceData, meta interface{}) error
 
 	err := meta.(*gokong.KongAdminClient).Upstreams().DeleteById(d.Id())
 
-	if err != nil {
+	if err != nil && !gokong.IsNotFound(err) {
 		return fmt.Errorf("could not delete kong upstream: %v", err)
 	}
 
 	return nil
 }
 
+func resourceKongUpstreamImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+
+	upstream, err := meta.(*gokong.KongAdminClient).Upstreams().GetByName(d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("could not import kong upstream: %v", err)
+	}
+
+	if upstream == nil {
+		return nil, fmt.Errorf("could not find kong upstream with id or name: %s", d.Id())
+	}
+
+	d.SetId(upstream.Id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func createKongUpstreamRequestFromResourceData(d *schema.ResourceData) *gokong.UpstreamRequest {
 
 	upstreamRequest := &gokong.UpstreamRequest{}
@@ -81,6 +276,137 @@ func createKongUpstreamRequestFromResourceData(d *schema.ResourceData) *gokong.U
 	upstreamRequest.Name = readStringFromResource(d, "name")
 	upstreamRequest.Slots = readIntFromResource(d, "slots")
 	upstreamRequest.OrderList = readIntArrayFromResource(d, "order_list")
+	upstreamRequest.HashOn = readStringFromResource(d, "hash_on")
+	upstreamRequest.HashFallback = readStringFromResource(d, "hash_fallback")
+	upstreamRequest.HashOnHeader = readStringFromResource(d, "hash_on_header")
+	upstreamRequest.HashFallbackHeader = readStringFromResource(d, "hash_fallback_header")
+	upstreamRequest.HealthChecks = expandUpstreamHealthChecks(d.Get("healthchecks").([]interface{}))
 
 	return upstreamRequest
 }
+
+func upstreamHealthCheckCounterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeInt,
+		Optional: true,
+		Default:  0,
+	}
+}
+
+func upstreamHealthCheckStatusesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeInt},
+	}
+}
+
+func expandUpstreamHealthChecks(list []interface{}) *gokong.UpstreamHealthCheck {
+
+	healthChecks := readBlock(list)
+	if healthChecks == nil {
+		return nil
+	}
+
+	result := &gokong.UpstreamHealthCheck{}
+
+	if active := readBlock(healthChecks["active"]); active != nil {
+		result.Active = &gokong.UpstreamHealthCheckActive{
+			HttpPath:    active["http_path"].(string),
+			Timeout:     active["timeout"].(int),
+			Concurrency: active["concurrency"].(int),
+		}
+		if healthy := readBlock(active["healthy"]); healthy != nil {
+			result.Active.Healthy = &gokong.ActiveHealthy{
+				HttpStatuses: readIntArray(healthy["http_statuses"]),
+				Interval:     healthy["interval"].(int),
+				Successes:    healthy["successes"].(int),
+			}
+		}
+		if unhealthy := readBlock(active["unhealthy"]); unhealthy != nil {
+			result.Active.Unhealthy = &gokong.ActiveUnhealthy{
+				HttpStatuses: readIntArray(unhealthy["http_statuses"]),
+				Interval:     unhealthy["interval"].(int),
+				TcpFailures:  unhealthy["tcp_failures"].(int),
+				Timeouts:     unhealthy["timeouts"].(int),
+				HttpFailures: unhealthy["http_failures"].(int),
+			}
+		}
+	}
+
+	if passive := readBlock(healthChecks["passive"]); passive != nil {
+		result.Passive = &gokong.UpstreamHealthCheckPassive{}
+		if healthy := readBlock(passive["healthy"]); healthy != nil {
+			result.Passive.Healthy = &gokong.PassiveHealthy{
+				HttpStatuses: readIntArray(healthy["http_statuses"]),
+				Successes:    healthy["successes"].(int),
+			}
+		}
+		if unhealthy := readBlock(passive["unhealthy"]); unhealthy != nil {
+			result.Passive.Unhealthy = &gokong.PassiveUnhealthy{
+				HttpStatuses: readIntArray(unhealthy["http_statuses"]),
+				TcpFailures:  unhealthy["tcp_failures"].(int),
+				Timeouts:     unhealthy["timeouts"].(int),
+				HttpFailures: unhealthy["http_failures"].(int),
+			}
+		}
+	}
+
+	return result
+}
+
+func flattenUpstreamHealthChecks(healthChecks *gokong.UpstreamHealthCheck) []interface{} {
+
+	if healthChecks == nil {
+		return []interface{}{}
+	}
+
+	result := map[string]interface{}{}
+
+	if active := healthChecks.Active; active != nil {
+		activeMap := map[string]interface{}{
+			"http_path":   active.HttpPath,
+			"timeout":     active.Timeout,
+			"concurrency": active.Concurrency,
+		}
+		if healthy := active.Healthy; healthy != nil {
+			activeMap["healthy"] = []interface{}{map[string]interface{}{
+				"http_statuses": healthy.HttpStatuses,
+				"interval":      healthy.Interval,
+				"successes":     healthy.Successes,
+			}}
+		}
+		if unhealthy := active.Unhealthy; unhealthy != nil {
+			activeMap["unhealthy"] = []interface{}{map[string]interface{}{
+				"http_statuses": unhealthy.HttpStatuses,
+				"interval":      unhealthy.Interval,
+				"tcp_failures":  unhealthy.TcpFailures,
+				"timeouts":      unhealthy.Timeouts,
+				"http_failures": unhealthy.HttpFailures,
+			}}
+		}
+		result["active"] = []interface{}{activeMap}
+	}
+
+	if passive := healthChecks.Passive; passive != nil {
+		passiveMap := map[string]interface{}{}
+		if healthy := passive.Healthy; healthy != nil {
+			passiveMap["healthy"] = []interface{}{map[string]interface{}{
+				"http_statuses": healthy.HttpStatuses,
+				"successes":     healthy.Successes,
+			}}
+		}
+		if unhealthy := passive.Unhealthy; unhealthy != nil {
+			passiveMap["unhealthy"] = []interface{}{map[string]interface{}{
+				"http_statuses": unhealthy.HttpStatuses,
+				"tcp_failures":  unhealthy.TcpFailures,
+				"timeouts":      unhealthy.Timeouts,
+				"http_failures": unhealthy.HttpFailures,
+			}}
+		}
+		result["passive"] = []interface{}{passiveMap}
+	}
+
+	return []interface{}{result}
+}
@@ -10,6 +10,8 @@ import (
 
 func TestAccKongUpstream(t *testing.T) {
 
+	var upstreamId string
+
 	resource.Test(t, resource.TestCase{
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckKongUpstreamDestroy,
@@ -18,6 +20,7 @@ func TestAccKongUpstream(t *testing.T) {
 				Config: testCreateUpstreamConfig,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckKongUpstreamExists("kong_upstream.upstream"),
+					testAccCheckKongUpstreamId("kong_upstream.upstream", &upstreamId),
 					resource.TestCheckResourceAttr("kong_upstream.upstream", "name", "MyUpstream"),
 					resource.TestCheckResourceAttr("kong_upstream.upstream", "slots", "10"),
 				),
@@ -26,8 +29,20 @@ func TestAccKongUpstream(t *testing.T) {
 				Config: testUpdateUpstreamConfig,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckKongUpstreamExists("kong_upstream.upstream"),
+					testAccCheckKongUpstreamId("kong_upstream.upstream", &upstreamId),
 					resource.TestCheckResourceAttr("kong_upstream.upstream", "name", "MyUpstream"),
 					resource.TestCheckResourceAttr("kong_upstream.upstream", "slots", "20"),
+					resource.TestCheckResourceAttr("kong_upstream.upstream", "order_list.#", "20"),
+				),
+			},
+			{
+				Config: testUpdateUpstreamNameAndSlotsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongUpstreamExists("kong_upstream.upstream"),
+					testAccCheckKongUpstreamId("kong_upstream.upstream", &upstreamId),
+					resource.TestCheckResourceAttr("kong_upstream.upstream", "name", "MyRenamedUpstream"),
+					resource.TestCheckResourceAttr("kong_upstream.upstream", "slots", "30"),
+					resource.TestCheckResourceAttr("kong_upstream.upstream", "order_list.#", "30"),
 				),
 			},
 		},
@@ -80,6 +95,70 @@ func TestAccKongUpstreamWithOrderList(t *testing.T) {
 	})
 }
 
+func TestAccKongUpstreamImport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongUpstreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateUpstreamConfig,
+			},
+			{
+				ResourceName:      "kong_upstream.upstream",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "kong_upstream.upstream",
+				ImportStateId:     "MyUpstream",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKongUpstreamWithHealthChecks(t *testing.T) {
+	testAccSkipBelowKongVersion(t, "0.12")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongUpstreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateUpstreamWithHealthChecksConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongUpstreamExists("kong_upstream.upstream"),
+					resource.TestCheckResourceAttr("kong_upstream.upstream", "hash_on", "header"),
+					resource.TestCheckResourceAttr("kong_upstream.upstream", "hash_fallback", "consumer"),
+					resource.TestCheckResourceAttr("kong_upstream.upstream", "hash_on_header", "X-Session-Id"),
+					resource.TestCheckResourceAttr("kong_upstream.upstream", "healthchecks.0.active.0.http_path", "/status"),
+					resource.TestCheckResourceAttr("kong_upstream.upstream", "healthchecks.0.active.0.timeout", "10"),
+					resource.TestCheckResourceAttr("kong_upstream.upstream", "healthchecks.0.active.0.concurrency", "20"),
+					resource.TestCheckResourceAttr("kong_upstream.upstream", "healthchecks.0.active.0.healthy.0.interval", "5"),
+					resource.TestCheckResourceAttr("kong_upstream.upstream", "healthchecks.0.active.0.healthy.0.successes", "2"),
+					resource.TestCheckResourceAttr("kong_upstream.upstream", "healthchecks.0.active.0.healthy.0.http_statuses.#", "2"),
+					resource.TestCheckResourceAttr("kong_upstream.upstream", "healthchecks.0.active.0.unhealthy.0.interval", "5"),
+					resource.TestCheckResourceAttr("kong_upstream.upstream", "healthchecks.0.active.0.unhealthy.0.http_failures", "3"),
+					resource.TestCheckResourceAttr("kong_upstream.upstream", "healthchecks.0.active.0.unhealthy.0.http_statuses.0", "500"),
+					resource.TestCheckResourceAttr("kong_upstream.upstream", "healthchecks.0.passive.0.unhealthy.0.tcp_failures", "4"),
+				),
+			},
+			{
+				Config: testUpdateUpstreamWithHealthChecksConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongUpstreamExists("kong_upstream.upstream"),
+					resource.TestCheckResourceAttr("kong_upstream.upstream", "hash_on", "ip"),
+					resource.TestCheckResourceAttr("kong_upstream.upstream", "healthchecks.0.active.0.http_path", "/health"),
+					resource.TestCheckResourceAttr("kong_upstream.upstream", "healthchecks.0.active.0.healthy.0.interval", "0"),
+					resource.TestCheckResourceAttr("kong_upstream.upstream", "healthchecks.0.active.0.unhealthy.0.interval", "0"),
+					resource.TestCheckResourceAttr("kong_upstream.upstream", "healthchecks.0.passive.0.unhealthy.0.tcp_failures", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckKongUpstreamDestroy(state *terraform.State) error {
 
 	client := testAccProvider.Meta().(*gokong.KongAdminClient)
@@ -130,6 +209,29 @@ func testAccCheckKongUpstreamExists(resourceKey string) resource.TestCheckFunc {
 	}
 }
 
+// testAccCheckKongUpstreamId records the id of the upstream on the first call and checks later calls see the same id,
+// so an update that replaces the upstream is caught
+func testAccCheckKongUpstreamId(resourceKey string, upstreamId *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if *upstreamId == "" {
+			*upstreamId = rs.Primary.ID
+			return nil
+		}
+
+		if rs.Primary.ID != *upstreamId {
+			return fmt.Errorf("expected upstream %s to be updated in place but it was replaced by %s", *upstreamId, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
 const testCreateUpstreamConfig = `
 resource "kong_upstream" "upstream" {
 	name  		= "MyUpstream"
@@ -143,6 +245,13 @@ resource "kong_upstream" "upstream" {
 }
 `
 
+const testUpdateUpstreamNameAndSlotsConfig = `
+resource "kong_upstream" "upstream" {
+	name  		= "MyRenamedUpstream"
+	slots 		= 30
+}
+`
+
 const testCreateUpstreamWithOrderList = `
 resource "kong_upstream" "upstream_orderlist" {
 	name  		= "MyOrderListUpstream"
@@ -158,3 +267,57 @@ resource "kong_upstream" "upstream_orderlist" {
 	order_list  = [ 7, 8, 9, 10, 3, 2, 1, 4, 5, 6,  ]
 }
 `
+const testCreateUpstreamWithHealthChecksConfig = `
+resource "kong_upstream" "upstream" {
+	name  		   = "MyHealthCheckedUpstream"
+	slots 		   = 10
+	hash_on        = "header"
+	hash_fallback  = "consumer"
+	hash_on_header = "X-Session-Id"
+	healthchecks   = {
+		active = {
+			http_path   = "/status"
+			timeout     = 10
+			concurrency = 20
+			healthy = {
+				interval      = 5
+				http_statuses = [ 200, 201 ]
+				successes     = 2
+			}
+			unhealthy = {
+				interval      = 5
+				http_statuses = [ 500 ]
+				http_failures = 3
+			}
+		}
+		passive = {
+			unhealthy = {
+				tcp_failures = 4
+			}
+		}
+	}
+}
+`
+const testUpdateUpstreamWithHealthChecksConfig = `
+resource "kong_upstream" "upstream" {
+	name  		   = "MyHealthCheckedUpstream"
+	slots 		   = 10
+	hash_on        = "ip"
+	healthchecks   = {
+		active = {
+			http_path   = "/health"
+			healthy = {
+				interval = 0
+			}
+			unhealthy = {
+				interval = 0
+			}
+		}
+		passive = {
+			unhealthy = {
+				tcp_failures = 1
+			}
+		}
+	}
+}
+`
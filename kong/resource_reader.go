@@ -20,6 +20,22 @@ func readStringArrayFromResource(d *schema.ResourceData, key string) []string {
 	return nil
 }
 
+func readStringSetFromResource(d *schema.ResourceData, key string) []string {
+
+	if attr, ok := d.GetOk(key); ok {
+		var array []string
+		items := attr.(*schema.Set).List()
+		for _, x := range items {
+			item := x.(string)
+			array = append(array, item)
+		}
+
+		return array
+	}
+
+	return nil
+}
+
 func readIntArrayFromResource(d *schema.ResourceData, key string) []int {
 
 	if attr, ok := d.GetOk(key); ok {
@@ -66,3 +82,21 @@ func readMapFromResource(d *schema.ResourceData, key string) map[string]interfac
 
 	return nil
 }
+
+// readBlock returns the single block held in a list with MaxItems of 1, or nil when the block is not set
+func readBlock(list interface{}) map[string]interface{} {
+	items, ok := list.([]interface{})
+	if !ok || len(items) == 0 || items[0] == nil {
+		return nil
+	}
+	return items[0].(map[string]interface{})
+}
+
+func readIntArray(list interface{}) []int {
+	var result []int
+	items, _ := list.([]interface{})
+	for _, item := range items {
+		result = append(result, item.(int))
+	}
+	return result
+}